@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quic-go/quic-go/internal/ackhandler (interfaces: ECNHandler)
+//
+// Generated by this command:
+//
+//	mockgen -typed -build_flags=-tags=gomock -package ackhandler -destination mock_ecn_handler_test.go github.com/quic-go/quic-go/internal/ackhandler ECNHandler
+//
+
+// Package ackhandler is a generated GoMock package.
+package ackhandler
+
+import (
+	reflect "reflect"
+
+	protocol "github.com/quic-go/quic-go/internal/protocol"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockECNHandler is a mock of ECNHandler interface.
+type MockECNHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockECNHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockECNHandlerMockRecorder is the mock recorder for MockECNHandler.
+type MockECNHandlerMockRecorder struct {
+	mock *MockECNHandler
+}
+
+// NewMockECNHandler creates a new mock instance.
+func NewMockECNHandler(ctrl *gomock.Controller) *MockECNHandler {
+	mock := &MockECNHandler{ctrl: ctrl}
+	mock.recorder = &MockECNHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockECNHandler) EXPECT() *MockECNHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleNewlyAcked mocks base method.
+func (m *MockECNHandler) HandleNewlyAcked(packets []packetWithPacketNumber, ect0, ect1, ecnce int64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleNewlyAcked", packets, ect0, ect1, ecnce)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HandleNewlyAcked indicates an expected call of HandleNewlyAcked.
+func (mr *MockECNHandlerMockRecorder) HandleNewlyAcked(packets, ect0, ect1, ecnce any) *MockECNHandlerHandleNewlyAckedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleNewlyAcked", reflect.TypeOf((*MockECNHandler)(nil).HandleNewlyAcked), packets, ect0, ect1, ecnce)
+	return &MockECNHandlerHandleNewlyAckedCall{Call: call}
+}
+
+// MockECNHandlerHandleNewlyAckedCall wrap *gomock.Call
+type MockECNHandlerHandleNewlyAckedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockECNHandlerHandleNewlyAckedCall) Return(congested bool) *MockECNHandlerHandleNewlyAckedCall {
+	c.Call = c.Call.Return(congested)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockECNHandlerHandleNewlyAckedCall) Do(f func([]packetWithPacketNumber, int64, int64, int64) bool) *MockECNHandlerHandleNewlyAckedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockECNHandlerHandleNewlyAckedCall) DoAndReturn(f func([]packetWithPacketNumber, int64, int64, int64) bool) *MockECNHandlerHandleNewlyAckedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LostPacket mocks base method.
+func (m *MockECNHandler) LostPacket(arg0 protocol.PacketNumber) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LostPacket", arg0)
+}
+
+// LostPacket indicates an expected call of LostPacket.
+func (mr *MockECNHandlerMockRecorder) LostPacket(arg0 any) *MockECNHandlerLostPacketCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LostPacket", reflect.TypeOf((*MockECNHandler)(nil).LostPacket), arg0)
+	return &MockECNHandlerLostPacketCall{Call: call}
+}
+
+// MockECNHandlerLostPacketCall wrap *gomock.Call
+type MockECNHandlerLostPacketCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockECNHandlerLostPacketCall) Return() *MockECNHandlerLostPacketCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockECNHandlerLostPacketCall) Do(f func(protocol.PacketNumber)) *MockECNHandlerLostPacketCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockECNHandlerLostPacketCall) DoAndReturn(f func(protocol.PacketNumber)) *MockECNHandlerLostPacketCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Mode mocks base method.
+func (m *MockECNHandler) Mode() protocol.ECN {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mode")
+	ret0, _ := ret[0].(protocol.ECN)
+	return ret0
+}
+
+// Mode indicates an expected call of Mode.
+func (mr *MockECNHandlerMockRecorder) Mode() *MockECNHandlerModeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mode", reflect.TypeOf((*MockECNHandler)(nil).Mode))
+	return &MockECNHandlerModeCall{Call: call}
+}
+
+// MockECNHandlerModeCall wrap *gomock.Call
+type MockECNHandlerModeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockECNHandlerModeCall) Return(arg0 protocol.ECN) *MockECNHandlerModeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockECNHandlerModeCall) Do(f func() protocol.ECN) *MockECNHandlerModeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockECNHandlerModeCall) DoAndReturn(f func() protocol.ECN) *MockECNHandlerModeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SentPacket mocks base method.
+func (m *MockECNHandler) SentPacket(arg0 protocol.PacketNumber, arg1 protocol.ECN) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SentPacket", arg0, arg1)
+}
+
+// SentPacket indicates an expected call of SentPacket.
+func (mr *MockECNHandlerMockRecorder) SentPacket(arg0, arg1 any) *MockECNHandlerSentPacketCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SentPacket", reflect.TypeOf((*MockECNHandler)(nil).SentPacket), arg0, arg1)
+	return &MockECNHandlerSentPacketCall{Call: call}
+}
+
+// MockECNHandlerSentPacketCall wrap *gomock.Call
+type MockECNHandlerSentPacketCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockECNHandlerSentPacketCall) Return() *MockECNHandlerSentPacketCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockECNHandlerSentPacketCall) Do(f func(protocol.PacketNumber, protocol.ECN)) *MockECNHandlerSentPacketCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockECNHandlerSentPacketCall) DoAndReturn(f func(protocol.PacketNumber, protocol.ECN)) *MockECNHandlerSentPacketCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
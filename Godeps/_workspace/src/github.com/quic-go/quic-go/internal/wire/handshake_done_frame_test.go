@@ -0,0 +1,16 @@
+package wire
+
+import (
+	"testing"
+
+	"github.com/quic-go/quic-go/internal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteHandshakeDoneSampleFrame(t *testing.T) {
+	frame := HandshakeDoneFrame{}
+	b, err := frame.Append(nil, protocol.Version1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{byte(FrameTypeHandshakeDone)}, b)
+	require.Equal(t, protocol.ByteCount(1), frame.Length(protocol.Version1))
+}
@@ -0,0 +1,9 @@
+// Copyright 2015 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv6_test
+
+func supportsIPv6MulticastDeliveryOnLoopback() (string, bool) {
+	return "", true
+}
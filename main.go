@@ -1,8 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pidah/urlstat/stat"
@@ -11,6 +20,28 @@ import (
 var DB = make(map[string]string)
 
 func main() {
+	// traces are requested by whoever reaches the service
+	stat.DefaultPolicy = stat.SafePolicy
+	if os.Getenv("ALLOW_INSECURE") == "1" {
+		stat.DefaultPolicy.AllowInsecure = true
+	}
+
+	var influx *stat.InfluxSink
+	if url := os.Getenv("INFLUX_URL"); url != "" {
+		influx = stat.NewInfluxSink(url)
+		defer influx.Close()
+	}
+
+	limit := newInFlightLimit(os.Getenv("MAX_IN_FLIGHT"))
+	metrics := stat.NewMetricsSink()
+	sessions := stat.NewSessions(maxSessions)
+
+	monitors, err := stat.NewMonitors(stat.NewMemoryMonitorStore(monitorHistory), maxMonitors)
+	if err != nil {
+		log.Fatalf("Unable to start monitors: %v", err)
+	}
+	defer monitors.Close()
+
 	r := gin.Default()
 
 	r.LoadHTMLGlob("templates/*")
@@ -19,14 +50,497 @@ func main() {
 		c.HTML(200, "index.html", gin.H{})
 	})
 
-	r.GET("/trace", handlePanic, func(c *gin.Context) {
-		url := c.Query("url")
+	r.GET("/health", func(c *gin.Context) {
+		c.JSON(200, gin.H{
+			"status":        "ok",
+			"in_flight":     limit.inFlight(),
+			"max_in_flight": limit.slots,
+		})
+	})
+
+	r.GET("/metrics", func(c *gin.Context) {
+		if stat.AcceptsOpenMetrics(c.Request.Header.Get("Accept")) {
+			c.Data(200, stat.OpenMetricsContentType, metrics.Expose(true))
+			return
+		}
+		c.Data(200, stat.PrometheusContentType, metrics.Expose(false))
+	})
+
+	// trace traces req with the options of the query and answers in
+	// the format asked for
+	trace := func(c *gin.Context, req *stat.Request) {
+		req.IncludeBody = c.Query("include_body") == "1"
+		req.CaptureTail = c.Query("capture_tail") == "1"
+		req.AuditHeaders = c.Query("audit_headers") == "1"
+		req.ShowTLS = c.Query("show_tls") == "1"
+		req.SSE = c.Query("sse") == "1"
+		req.CacheKey = c.Query("cache_key") == "1"
+		req.CheckCSP = c.Query("csp") == "1"
+		req.HappyEyeballs = c.Query("happy_eyeballs") == "1"
+		req.ShowTTL = c.Query("show_ttl") == "1"
+		req.HTTP2Streams = c.Query("http2_streams") == "1"
+		req.Subresources = c.Query("subresources") == "1"
+		req.BodyJSONPath = c.Query("body_json_path")
+		req.Shadow = c.Query("shadow")
+		req.Referer = c.Query("referer")
+		req.Origin = c.Query("origin")
+		var err error
+		if req.ExpectTTLMin, err = queryDuration(c, "ttl_min"); err != nil {
+			fail(c, err)
+			return
+		}
+		if req.ExpectTTLMax, err = queryDuration(c, "ttl_max"); err != nil {
+			fail(c, err)
+			return
+		}
+		if req.TraceSubresources, err = queryInt(c, "trace_subresources"); err != nil {
+			fail(c, err)
+			return
+		}
+		switch c.Query("preflight") {
+		case "1":
+			req.Preflight = true
+		case "only":
+			req.PreflightOnly = true
+		}
+		switch p := c.Query("proto"); p {
+		case "":
+		case "h1":
+			req.ForceHTTP = stat.ForceHTTP1
+		case "h2":
+			req.ForceHTTP = stat.ForceHTTP2
+		case "h3":
+			req.ForceHTTP = stat.ForceHTTP3
+		default:
+			fail(c, errors.New("Invalid proto "+p+", expected h1, h2 or h3"))
+			return
+		}
+		if t := c.Query("timeout"); t != "" {
+			d, err := time.ParseDuration(t)
+			if err != nil || d <= 0 {
+				fail(c, errors.New("Invalid timeout "+t+", expected a duration such as 10s"))
+				return
+			}
+			req.Timeout = d
+		}
+
+		release, ok := limit.acquire(c, req.Traces())
+		if !ok {
+			return
+		}
+		defer release()
+
+		resp, err := stat.Trace(c.Request.Context(), req)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if influx != nil {
+			influx.Record(req, resp)
+		}
+		traceID := stat.TraceID(c.Request.Header.Get("traceparent"))
+		metrics.Record(resp, traceID)
+
+		switch c.Query("format") {
+		case "line":
+			line, _ := stat.Render("line", resp)
+			c.String(200, "%s\n", line)
+			return
+		case "chrome":
+			events, err := stat.Render("chrome", resp)
+			if err != nil {
+				fail(c, err)
+				return
+			}
+			c.Data(200, "application/json", []byte(events))
+			return
+		case "grafana":
+			series, err := stat.Render("grafana", resp)
+			if err != nil {
+				fail(c, err)
+				return
+			}
+			c.Data(200, "application/json", []byte(series))
+			return
+		case "proto":
+			c.Data(200, stat.ProtoContentType, resp.MarshalProto())
+			return
+		case "json":
+			c.JSON(200, resp.Result())
+			return
+		}
 
-		resp := stat.Trace(stat.NewRequest(url))
 		c.JSON(200, gin.H{
-			"status": "ok",
-			"trace":  resp.String(),
+			"status":           "ok",
+			"trace":            resp.String(),
+			"trace_id":         traceID,
+			"result":           resp.Result(),
+			"status_code":      resp.StatusCode,
+			"timings":          resp.Timings,
+			"final_url":        resp.FinalURL,
+			"events":           resp.Events,
+			"compression":      resp.Compression,
+			"cache":            resp.Cache,
+			"csp":              resp.CSP,
+			"happy_eyeballs":   resp.HappyEyeballs,
+			"dns_records":      resp.DNSRecords,
+			"http2":            resp.HTTP2,
+			"alt_svc":          resp.AltSvc,
+			"subresources":     resp.Subresources,
+			"body":             resp.Body,
+			"body_json":        resp.BodyJSON,
+			"header_anomalies": resp.HeaderAnomalies,
+			"tls":              resp.TLS,
+			"cors":             resp.CORS,
+			"preflight":        resp.Preflight,
+			"shadow":           resp.Shadow,
 		})
+	}
+
+	r.GET("/trace", func(c *gin.Context) {
+		req, err := stat.NewRequest(c.Query("url"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		trace(c, req)
+	})
+
+	// POST takes the request to trace as JSON, see traceOptions
+	r.POST("/trace", func(c *gin.Context) {
+		var o traceOptions
+		if err := json.NewDecoder(io.LimitReader(c.Request.Body, maxTraceOptions)).Decode(&o); err != nil {
+			fail(c, errors.New("Invalid trace options: "+err.Error()))
+			return
+		}
+		req, err := o.request()
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		trace(c, req)
+	})
+
+	r.GET("/trace/vantage", limit.handle, func(c *gin.Context) {
+		req, err := stat.NewRequest(c.Query("url"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+
+		tracer := stat.NewTracer()
+		defer tracer.CloseIdleConnections()
+
+		points := append([]stat.VantagePoint{
+			&stat.LocalVantagePoint{Label: "local", Tracer: tracer},
+		}, vantagePoints()...)
+
+		m := stat.TraceFrom(c.Request.Context(), req, points)
+
+		results := make([]gin.H, 0, len(m.Results))
+		for _, v := range m.Results {
+			if v.Err != nil {
+				results = append(results, gin.H{"name": v.Name, "error": v.Err.Error()})
+				continue
+			}
+			results = append(results, gin.H{
+				"name":        v.Name,
+				"status_code": v.Response.StatusCode,
+				"timings":     v.Response.Timings,
+				"outlier":     v.Outlier,
+			})
+		}
+
+		c.JSON(200, gin.H{
+			"status":  "ok",
+			"trace":   m.String(),
+			"results": results,
+		})
+	})
+
+	// the URLs are posted as {"urls": [...]}, the options in the query
+	r.POST("/trace/batch", func(c *gin.Context) {
+		var body struct {
+			URLs []string `json:"urls"`
+		}
+		if err := json.NewDecoder(io.LimitReader(c.Request.Body, maxBatchBody)).Decode(&body); err != nil {
+			fail(c, errors.New("Invalid batch, expected {\"urls\": [...]}: "+err.Error()))
+			return
+		}
+
+		req, err := stat.NewRequest("")
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if req.MaxConcurrency, err = queryInt(c, "concurrency"); err != nil {
+			fail(c, err)
+			return
+		}
+		if req.TotalBudget, err = queryDuration(c, "budget"); err != nil {
+			fail(c, err)
+			return
+		}
+		d, err := queryDuration(c, "timeout")
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if d > 0 {
+			req.Timeout = d
+		}
+
+		release, ok := limit.acquire(c, req.BatchTraces(len(body.URLs)))
+		if !ok {
+			return
+		}
+		defer release()
+
+		tracer := stat.NewTracer()
+		defer tracer.CloseIdleConnections()
+
+		b, err := tracer.Batch(c.Request.Context(), req, body.URLs)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+
+		results := make(map[string]gin.H, len(b.Results))
+		for _, res := range b.Results {
+			if res.Err != "" {
+				results[res.URL] = gin.H{"error": res.Err}
+				continue
+			}
+			results[res.URL] = gin.H{
+				"status_code": res.Response.StatusCode,
+				"proto":       res.Response.Proto,
+				"timings":     res.Response.Timings,
+				"warnings":    res.Response.Warnings,
+			}
+		}
+
+		c.JSON(200, gin.H{
+			"status":          "ok",
+			"trace":           b.String(),
+			"failed":          b.Failed,
+			"duration":        b.Duration,
+			"budget_exceeded": b.BudgetExceeded,
+			"results":         results,
+		})
+	})
+
+	r.GET("/trace/sitemap", func(c *gin.Context) {
+		req, err := stat.NewRequest(c.Query("url"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if req.MaxURLs, err = queryInt(c, "max_urls"); err != nil {
+			fail(c, err)
+			return
+		}
+		if req.MaxConcurrency, err = queryInt(c, "concurrency"); err != nil {
+			fail(c, err)
+			return
+		}
+
+		release, ok := limit.acquire(c, req.SitemapTraces())
+		if !ok {
+			return
+		}
+		defer release()
+
+		tracer := stat.NewTracer()
+		defer tracer.CloseIdleConnections()
+
+		site, err := tracer.Sitemap(c.Request.Context(), req)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+
+		min, median, max := site.Totals()
+		c.JSON(200, gin.H{
+			"status":         "ok",
+			"trace":          site.String(),
+			"statuses":       site.StatusClasses(),
+			"total":          gin.H{"min": min, "median": median, "max": max},
+			"slowest":        site.Slowest(10),
+			"failing":        site.Failing(),
+			"pages":          site.Pages,
+			"sitemaps":       site.Sitemaps,
+			"sitemap_errors": site.SitemapErrors,
+			"malformed":      site.Malformed,
+			"truncated":      site.Truncated,
+		})
+	})
+
+	r.GET("/trace/ports", func(c *gin.Context) {
+		req, err := stat.NewRequest(c.Query("url"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		ports, err := stat.ParsePorts(c.Query("ports"))
+		if err != nil {
+			fail(c, errors.New("Invalid ports: "+err.Error()))
+			return
+		}
+
+		traceHTTP := c.Query("http") == "1"
+		release, ok := limit.acquire(c, req.PortsTraces(len(ports), traceHTTP))
+		if !ok {
+			return
+		}
+		defer release()
+
+		tracer := stat.NewTracer()
+		defer tracer.CloseIdleConnections()
+
+		p, err := tracer.ProbePorts(c.Request.Context(), req, ports, traceHTTP)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+
+		results := make([]gin.H, 0, len(p.Ports))
+		for _, pp := range p.Ports {
+			result := gin.H{"probe": pp}
+			if pp.Response != nil {
+				result["status_code"] = pp.Response.StatusCode
+				result["timings"] = pp.Response.Timings
+			}
+			results = append(results, result)
+		}
+
+		c.JSON(200, gin.H{
+			"status":     "ok",
+			"trace":      p.String(),
+			"host":       p.Host,
+			"addr":       p.Addr,
+			"dns_lookup": p.DNSLookup,
+			"ports":      results,
+		})
+	})
+
+	// a session keeps the connection of a trace open for the next ones
+	r.POST("/session", limit.handle, func(c *gin.Context) {
+		req, err := stat.NewRequest(c.Query("url"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		var idle time.Duration
+		if v := c.Query("idle"); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				fail(c, errors.New("Invalid idle "+v+", expected a duration such as 30s"))
+				return
+			}
+			idle = d
+		}
+
+		s, resp, err := sessions.Open(c.Request.Context(), req, idle)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{
+			"status":      "ok",
+			"session":     s.ID,
+			"trace":       resp.String(),
+			"status_code": resp.StatusCode,
+			"timings":     resp.Timings,
+		})
+	})
+
+	r.GET("/session/:id/trace", limit.handle, func(c *gin.Context) {
+		s := sessions.Get(c.Param("id"))
+		if s == nil {
+			fail(c, errors.New("No such session, it may have timed out"))
+			return
+		}
+
+		resp, turn, err := s.Trace(c.Request.Context(), c.DefaultQuery("path", "/"))
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{
+			"status":      "ok",
+			"session":     s.ID,
+			"trace":       resp.String(),
+			"status_code": resp.StatusCode,
+			"timings":     resp.Timings,
+			"turn":        turn,
+		})
+	})
+
+	r.DELETE("/session/:id", func(c *gin.Context) {
+		if s := sessions.Get(c.Param("id")); s != nil {
+			s.Close()
+		}
+		c.JSON(200, gin.H{"status": "ok"})
+	})
+
+	// monitors trace their URL in the background, every interval
+	r.POST("/monitors", limit.handle, func(c *gin.Context) {
+		interval, err := queryDuration(c, "interval")
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if interval == 0 {
+			interval = defaultMonitorInterval
+		}
+		timeout, err := queryDuration(c, "timeout")
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		m, err := monitors.Add(c.Query("url"), interval, timeout)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"status": "ok", "monitor": m})
+	})
+
+	r.GET("/monitors", limit.handle, func(c *gin.Context) {
+		list, err := monitors.List()
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"status": "ok", "monitors": list})
+	})
+
+	r.GET("/monitors/:id/history", limit.handle, func(c *gin.Context) {
+		n, err := queryInt(c, "limit")
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		h, err := monitors.History(c.Param("id"), n)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{
+			"status":  "ok",
+			"trace":   h.String(),
+			"monitor": h.Monitor,
+			"uptime":  h.Uptime,
+			"checks":  h.Checks,
+		})
+	})
+
+	r.DELETE("/monitors/:id", limit.handle, func(c *gin.Context) {
+		if err := monitors.Remove(c.Param("id")); err != nil {
+			fail(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"status": "ok"})
 	})
 
 	r.StaticFS("/static", http.Dir("static"))
@@ -34,15 +548,238 @@ func main() {
 	r.Run(":" + os.Getenv("PORT"))
 }
 
-func handlePanic(c *gin.Context) {
-	defer func() {
-		if err := recover(); err != nil {
-			c.JSON(200, gin.H{
-				"status":  "err",
-				"message": err,
-			})
+// vantagePoints returns the remote urlstat instances listed in
+// VANTAGE_POINTS as comma separated name=url pairs.
+func vantagePoints() []stat.VantagePoint {
+	var points []stat.VantagePoint
+	for _, v := range strings.Split(os.Getenv("VANTAGE_POINTS"), ",") {
+		i := strings.Index(v, "=")
+		if i == -1 {
+			continue
+		}
+		points = append(points, &stat.RemoteVantagePoint{
+			Label:   strings.TrimSpace(v[:i]),
+			BaseURL: strings.TrimSpace(v[i+1:]),
+		})
+	}
+	return points
+}
+
+// queryInt returns the query parameter key as a number, zero when it is
+// missing.
+func queryInt(c *gin.Context, key string) (int, error) {
+	v := c.Query(key)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, errors.New("Invalid " + key + " " + v + ", expected a positive number")
+	}
+	return n, nil
+}
+
+// queryDuration returns the query parameter key as a duration, zero
+// when it is missing.
+func queryDuration(c *gin.Context, key string) (time.Duration, error) {
+	v := c.Query(key)
+	if v == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, errors.New("Invalid " + key + " " + v + ", expected a duration such as 5m")
+	}
+	return d, nil
+}
+
+// traceOptions are the options of the request POST /trace traces, the
+// others come from the query as for GET.
+type traceOptions struct {
+	URL    string `json:"url"`
+	Method string `json:"method"`
+
+	// Headers are "Name: value" lines. A Host header overrides the host
+	// sent and the name presented as SNI. Authorization, Cookie and Host
+	// lines are dropped by redirects to other hosts.
+	Headers []string `json:"headers"`
+	Body    string   `json:"body"`
+
+	// BasicAuth, as "user:password", or BearerToken set the
+	// Authorization header, for the host of URL only.
+	BasicAuth   string `json:"basic_auth"`
+	BearerToken string `json:"bearer_token"`
+
+	// Insecure skips certificate verification, which the service only
+	// allows with ALLOW_INSECURE=1.
+	Insecure bool `json:"insecure"`
+
+	// nil keeps the defaults of stat.NewRequest
+	FollowRedirects *bool `json:"follow_redirects"`
+	MaxRedirects    *int  `json:"max_redirects"`
+}
+
+// Limits of traceOptions.
+const (
+	maxTraceHeaders   = 64
+	maxTraceBody      = 1 << 20
+	maxTraceRedirects = 10
+
+	// room for a body of maxTraceBody, escaped, and the rest
+	maxTraceOptions = 4*maxTraceBody + 64<<10
+)
+
+// request returns the request o describes, failing on invalid options.
+func (o *traceOptions) request() (*stat.Request, error) {
+	req, err := stat.NewRequest(o.URL)
+	if err != nil {
+		return nil, err
+	}
+	if o.Method != "" {
+		req.HTTPMethod = strings.ToUpper(o.Method)
+	}
+
+	if len(o.Headers) > maxTraceHeaders {
+		return nil, fmt.Errorf("At most %d headers may be sent", maxTraceHeaders)
+	}
+	for _, h := range o.Headers {
+		i := strings.Index(h, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("Invalid header %q, expected \"Name: value\"", h)
+		}
+		req.HTTPHeaders = append(req.HTTPHeaders, h)
+	}
+
+	if len(o.Body) > maxTraceBody {
+		return nil, fmt.Errorf("Body must be %d bytes at most", maxTraceBody)
+	}
+	req.PostBody = o.Body
+
+	req.BasicAuth = o.BasicAuth
+	req.BearerToken = o.BearerToken
+	req.Insecure = o.Insecure
+	if o.FollowRedirects != nil {
+		req.FollowRedirects = *o.FollowRedirects
+	}
+	if o.MaxRedirects != nil {
+		if *o.MaxRedirects < 0 || *o.MaxRedirects > maxTraceRedirects {
+			return nil, fmt.Errorf("max_redirects must be between 0 and %d", maxTraceRedirects)
+		}
+		req.MaxRedirects = *o.MaxRedirects
+	}
+	return req, nil
+}
+
+// maxBatchBody bounds the body of a batch, room for its URLs.
+const maxBatchBody = 1 << 20
+
+// maxSessions is how many sessions may be open at once, each holding a
+// connection.
+const maxSessions = 16
+
+// maxMonitors is how many monitors may be added, monitorHistory how
+// many of their checks are kept, a day's worth at the default interval.
+const (
+	maxMonitors            = 32
+	monitorHistory         = 1440
+	defaultMonitorInterval = time.Minute
+)
+
+// defaultMaxInFlight is how many traces run at once when MAX_IN_FLIGHT
+// is not set.
+const defaultMaxInFlight = 64
+
+// inFlightLimit is a semaphore bounding the traces running at once
+// across all clients, to stay within the file descriptors and memory
+// of the host. Routes starting several traces at once take a slot for
+// each.
+type inFlightLimit struct {
+	mu       sync.Mutex
+	n, slots int
+}
+
+func newInFlightLimit(env string) *inFlightLimit {
+	n := defaultMaxInFlight
+	if env != "" {
+		v, err := strconv.Atoi(env)
+		if err != nil || v <= 0 {
+			log.Fatalf("Invalid MAX_IN_FLIGHT %q, expected a positive number", env)
 		}
-	}()
+		n = v
+	}
+	return &inFlightLimit{slots: n}
+}
+
+// acquire takes n slots, all of them when n exceeds the limit, and
+// returns the function giving them back. When they are not free, it
+// answers 503 right away and returns false.
+func (l *inFlightLimit) acquire(c *gin.Context, n int) (func(), bool) {
+	if n < 1 {
+		n = 1
+	}
+	if n > l.slots {
+		n = l.slots
+	}
 
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.n+n > l.slots {
+		c.Header("Retry-After", "1")
+		c.JSON(503, gin.H{
+			"status":  "err",
+			"message": "Too many traces in flight, retry later",
+		})
+		return nil, false
+	}
+	l.n += n
+	return func() {
+		l.mu.Lock()
+		l.n -= n
+		l.mu.Unlock()
+	}, true
+}
+
+// handle runs the rest of the chain with a slot, for routes running a
+// single trace at a time.
+func (l *inFlightLimit) handle(c *gin.Context) {
+	release, ok := l.acquire(c, 1)
+	if !ok {
+		c.Abort()
+		return
+	}
+	defer release()
 	c.Next()
 }
+
+func (l *inFlightLimit) inFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.n
+}
+
+// fail answers with err: the status of its kind for a failed trace, 400
+// for the invalid parameters of a request otherwise.
+func fail(c *gin.Context, err error) {
+	code := 400
+	h := gin.H{"status": "err", "message": err.Error()}
+	var te *stat.TraceError
+	if errors.As(err, &te) {
+		code = traceErrorStatus[te.Kind]
+		h["kind"] = te.Kind
+	}
+	c.JSON(code, h)
+}
+
+// traceErrorStatus is the status to answer a failed trace with, by the
+// kind of its failure.
+var traceErrorStatus = map[stat.ErrorKind]int{
+	stat.ErrorInvalid: 400,
+	stat.ErrorDNS:     502,
+	stat.ErrorConnect: 502,
+	stat.ErrorTLS:     502,
+	stat.ErrorFailed:  502,
+	stat.ErrorTimeout: 504,
+
+	// the client went away, as nginx logs it
+	stat.ErrorCanceled: 499,
+}
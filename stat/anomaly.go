@@ -0,0 +1,209 @@
+package stat
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// HeaderAnomaly is a suspicious construct in the raw header block of a
+// response, one that clients and intermediaries may read differently.
+type HeaderAnomaly struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// maxRawHeader bounds how much of a raw response auditHeaders reads.
+const maxRawHeader = 64 << 10
+
+// auditHeaders fetches the URL of r again over a fresh HTTP/1.1
+// connection, reading the response header block as raw bytes. Go's
+// client normalizes or rejects the constructs looked for here, which is
+// why they have to be found on the wire.
+func (r *Request) auditHeaders(ctx context.Context, policy *Policy) ([]HeaderAnomaly, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if !policy.AllowPrivateTargets {
+		dialer.Control = controlDial
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, r.network(), net.JoinHostPort(r.URL.Hostname(), portOf(r.URL)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if d, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(d)
+	}
+
+	if r.URL.Scheme == "https" {
+		name := r.serverName()
+		if name == "" {
+			name = r.URL.Hostname()
+		}
+		tc := tls.Client(conn, &tls.Config{
+			ServerName:         name,
+			InsecureSkipVerify: r.Insecure,
+			NextProtos:         []string{"http/1.1"},
+		})
+		if err := tc.Handshake(); err != nil {
+			return nil, err
+		}
+		conn = tc
+	}
+
+	req, err := r.cook()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s HTTP/1.1\r\nHost: %s\r\n", req.Method, req.URL.RequestURI(), req.Host)
+	req.Header.Set("Connection", "close")
+	req.Header.Write(&buf)
+	buf.WriteString("\r\n")
+
+	if _, err := conn.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	raw, err := readRawHeader(bufio.NewReader(conn))
+	if err != nil {
+		return nil, err
+	}
+	return findAnomalies(raw), nil
+}
+
+// auditable reports whether the method is safe to send a second time.
+func (r *Request) auditable() bool {
+	return r.HTTPMethod == "GET" || r.HTTPMethod == "HEAD"
+}
+
+// readRawHeader reads the status line and headers, up to and including
+// the empty line, exactly as sent.
+func readRawHeader(br *bufio.Reader) ([]byte, error) {
+	var raw []byte
+	for len(raw) < maxRawHeader {
+		line, err := br.ReadBytes('\n')
+		raw = append(raw, line...)
+		if err != nil {
+			return nil, fmt.Errorf("reading raw header: %v", err)
+		}
+		if len(bytes.TrimRight(line, "\r\n")) == 0 {
+			return raw, nil
+		}
+	}
+	return nil, fmt.Errorf("raw header larger than %d bytes", maxRawHeader)
+}
+
+func findAnomalies(raw []byte) []HeaderAnomaly {
+	var found []HeaderAnomaly
+	add := func(code, format string, argv ...interface{}) {
+		found = append(found, HeaderAnomaly{code, fmt.Sprintf(format, argv...)})
+	}
+
+	lines := strings.SplitAfter(string(raw), "\n")
+	var contentLength, transferEncoding []string
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		if !strings.HasSuffix(line, "\r\n") {
+			add("bare-lf", "line %d ends in a bare LF instead of CRLF", i+1)
+		}
+
+		text := strings.TrimRight(line, "\r\n")
+		if strings.ContainsAny(text, "\r\n") {
+			add("embedded-cr", "line %d contains a CR in the middle of it", i+1)
+		}
+		if i == 0 || text == "" {
+			continue
+		}
+
+		if text[0] == ' ' || text[0] == '\t' {
+			add("obs-fold", "line %d continues the previous header (obsolete line folding)", i+1)
+			continue
+		}
+
+		j := strings.Index(text, ":")
+		if j == -1 {
+			add("no-colon", "line %d is not a header: %q", i+1, text)
+			continue
+		}
+		name := text[:j]
+		if strings.TrimRight(name, " \t") != name {
+			add("space-before-colon", "header %q has whitespace before the colon", strings.TrimSpace(name))
+		}
+
+		value := strings.TrimSpace(text[j+1:])
+		switch textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name)) {
+		case "Content-Length":
+			contentLength = append(contentLength, value)
+		case "Transfer-Encoding":
+			transferEncoding = append(transferEncoding, value)
+		}
+	}
+
+	if len(contentLength) > 1 {
+		add("duplicate-content-length", "Content-Length sent %d times: %s",
+			len(contentLength), strings.Join(contentLength, ", "))
+	}
+	for _, v := range contentLength {
+		if strings.Contains(v, ",") {
+			add("content-length-list", "Content-Length holds a list: %s", v)
+		}
+	}
+	if len(transferEncoding) > 0 && len(contentLength) > 0 {
+		add("te-and-content-length", "both Transfer-Encoding (%s) and Content-Length (%s) are set",
+			strings.Join(transferEncoding, ", "), strings.Join(contentLength, ", "))
+	}
+	if len(transferEncoding) > 1 {
+		add("duplicate-transfer-encoding", "Transfer-Encoding sent %d times: %s",
+			len(transferEncoding), strings.Join(transferEncoding, ", "))
+	}
+	return found
+}
+
+func (a HeaderAnomaly) String() string {
+	return a.Description
+}
+
+func joinAnomalies(found []HeaderAnomaly) string {
+	o := make([]string, len(found))
+	for i, a := range found {
+		o[i] = a.String()
+	}
+	return strings.Join(o, "; ")
+}
+
+// reportAnomalies audits the headers of the final response and records
+// the findings as warnings on w.
+func (r *Request) reportAnomalies(ctx context.Context, policy *Policy, w *Response) {
+	if !r.auditable() {
+		w.report("Header audit skipped for %s", r.HTTPMethod)
+		return
+	}
+
+	found, err := r.auditHeaders(ctx, policy)
+	if err != nil {
+		w.report("Header audit failed: %v", err)
+		return
+	}
+	w.HeaderAnomalies = found
+	if len(found) == 0 {
+		w.report("Header audit: no anomalies")
+	}
+	for _, a := range found {
+		w.warn(WarnHeaderAnomaly, "%s", a)
+	}
+}
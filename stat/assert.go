@@ -0,0 +1,532 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An Assertion is a predicate on the outcome of a trace, such as
+//
+//	status==200 && total_ms<500 && header["Content-Type"]~="json"
+//
+// The grammar is:
+//
+//	expr       = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" expr ")" | comparison
+//	comparison = operand [ op operand ]
+//	op         = "==" | "!=" | "<" | "<=" | ">" | ">=" | "~="
+//	operand    = number | string | "true" | "false" | name | "header" "[" string "]"
+//
+// Strings are double quoted, with Go escapes. "~=" matches the string on
+// its left against the regular expression on its right. "<", "<=", ">"
+// and ">=" compare numbers only, "==" and "!=" any two values of the same
+// type. A comparison without operator must be a boolean.
+//
+// Names are the values of the final hop:
+//
+//	status       status code
+//	method, url  method and URL
+//	reused       whether the connection was reused
+//	redirects    number of redirects followed
+//	dns_ms, connect_ms, tls_ms, server_ms, transfer_ms, total_ms
+//	             phase timings, in milliseconds
+//	body_size    size of the body in bytes
+//	body         captured body, with Request.IncludeBody, or the value
+//	             found by Request.BodyJSONPath: a number, string or
+//	             boolean as such, anything else as JSON
+//	cert_days    days until the certificate expires, over TLS only
+//	dns_ttl      lowest TTL of the records of the host in seconds, with
+//	             Request.ShowTTL
+//	header["X"]  the values of response header X, comma separated
+type Assertion struct {
+	Expr string
+	root assertNode
+}
+
+// AssertionResult is the outcome of evaluating an Assertion.
+type AssertionResult struct {
+	Expr   string `json:"expr"`
+	Passed bool   `json:"passed"`
+
+	// Err is set when the assertion could not be evaluated, it then
+	// counts as failed.
+	Err string `json:"error,omitempty"`
+}
+
+func (a AssertionResult) String() string {
+	switch {
+	case a.Err != "":
+		return fmt.Sprintf("Assertion failed: %s (%s)", a.Expr, a.Err)
+	case a.Passed:
+		return fmt.Sprintf("Assertion passed: %s", a.Expr)
+	default:
+		return fmt.Sprintf("Assertion failed: %s", a.Expr)
+	}
+}
+
+// ParseAssertion parses expr, reporting syntax errors with the column
+// they were found at.
+func ParseAssertion(expr string) (*Assertion, error) {
+	p := &assertParser{src: expr}
+	p.next()
+	root, err := p.expr()
+	if err == nil && p.tok.kind != tokEOF {
+		err = p.errorf("unexpected %s", p.tok)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Assertion{Expr: expr, root: root}, nil
+}
+
+// Eval evaluates a against resp.
+func (a *Assertion) Eval(resp *Response) AssertionResult {
+	res := AssertionResult{Expr: a.Expr}
+	v, err := a.root.eval(resp)
+	switch {
+	case err != nil:
+		res.Err = err.Error()
+	case v.kind != kindBool:
+		res.Err = fmt.Sprintf("result is a %s, not a boolean", v.kind)
+	default:
+		res.Passed = v.b
+	}
+	return res
+}
+
+// validateAssertions parses exprs, failing on the first that does not
+// parse.
+func validateAssertions(exprs []string) ([]*Assertion, error) {
+	var o []*Assertion
+	for _, expr := range exprs {
+		a, err := ParseAssertion(expr)
+		if err != nil {
+			return nil, invalidf("Invalid assertion %q: %v", expr, err)
+		}
+		o = append(o, a)
+	}
+	return o, nil
+}
+
+// values
+
+type assertKind int
+
+const (
+	kindNumber assertKind = iota
+	kindString
+	kindBool
+)
+
+func (k assertKind) String() string {
+	return [...]string{"number", "string", "boolean"}[k]
+}
+
+type assertValue struct {
+	kind assertKind
+	n    float64
+	s    string
+	b    bool
+}
+
+func assertNumber(n float64) assertValue { return assertValue{kind: kindNumber, n: n} }
+func assertString(s string) assertValue  { return assertValue{kind: kindString, s: s} }
+func assertBool(b bool) assertValue      { return assertValue{kind: kindBool, b: b} }
+
+// nodes
+
+type assertNode interface {
+	eval(resp *Response) (assertValue, error)
+}
+
+type assertLiteral struct{ v assertValue }
+
+func (n assertLiteral) eval(*Response) (assertValue, error) { return n.v, nil }
+
+type assertName string
+
+func (n assertName) eval(resp *Response) (assertValue, error) {
+	ms := func(d time.Duration) assertValue {
+		return assertNumber(float64(d) / float64(time.Millisecond))
+	}
+
+	t := resp.Timings
+	switch n {
+	case "status":
+		return assertNumber(float64(resp.StatusCode)), nil
+	case "method":
+		return assertString(resp.method), nil
+	case "url":
+		return assertString(resp.url), nil
+	case "reused":
+		return assertBool(resp.Reused), nil
+	case "redirects":
+		return assertNumber(float64(len(resp.Redirects))), nil
+	case "dns_ms":
+		return ms(t.DNSLookup), nil
+	case "connect_ms":
+		return ms(t.TCPConnection), nil
+	case "tls_ms":
+		return ms(t.TLSHandshake), nil
+	case "server_ms":
+		return ms(t.ServerProcessing), nil
+	case "transfer_ms":
+		return ms(t.ContentTransfer), nil
+	case "total_ms":
+		return ms(t.Total), nil
+	case "body_size":
+		if resp.Sizes == nil {
+			return assertNumber(0), nil
+		}
+		return assertNumber(float64(resp.Sizes.Body)), nil
+	case "body":
+		if resp.BodyJSON != nil {
+			return resp.BodyJSON.value()
+		}
+		if resp.Body == nil {
+			return assertValue{}, fmt.Errorf("body was not captured")
+		}
+		return assertString(resp.Body.Content), nil
+	case "cert_days":
+		if resp.TLS == nil {
+			return assertValue{}, fmt.Errorf("no TLS connection")
+		}
+		return assertNumber(float64(resp.TLS.DaysRemaining)), nil
+	case "dns_ttl":
+		if len(resp.DNSRecords) == 0 {
+			return assertValue{}, fmt.Errorf("no DNS records, ShowTTL is needed")
+		}
+		return assertNumber(minTTL(resp.DNSRecords).Seconds()), nil
+	}
+	// names are checked when parsing, this is only reached on a bug
+	return assertValue{}, fmt.Errorf("unknown name %s", string(n))
+}
+
+var assertNames = map[string]bool{
+	"status": true, "method": true, "url": true, "reused": true, "redirects": true,
+	"dns_ms": true, "connect_ms": true, "tls_ms": true, "server_ms": true,
+	"transfer_ms": true, "total_ms": true, "body_size": true, "body": true,
+	"cert_days": true, "dns_ttl": true,
+}
+
+type assertHeader string
+
+func (n assertHeader) eval(resp *Response) (assertValue, error) {
+	return assertString(strings.Join(resp.Header[string(n)], ",")), nil
+}
+
+type assertNot struct{ x assertNode }
+
+func (n assertNot) eval(resp *Response) (assertValue, error) {
+	v, err := n.x.eval(resp)
+	if err != nil {
+		return v, err
+	}
+	if v.kind != kindBool {
+		return assertValue{}, fmt.Errorf("! applied to a %s", v.kind)
+	}
+	return assertBool(!v.b), nil
+}
+
+// assertLogical is && or ||, evaluated left to right and short-circuiting.
+type assertLogical struct {
+	op   string
+	x, y assertNode
+}
+
+func (n assertLogical) eval(resp *Response) (assertValue, error) {
+	x, err := n.operand(n.x, resp)
+	if err != nil || x.b == (n.op == "||") {
+		return x, err
+	}
+	return n.operand(n.y, resp)
+}
+
+func (n assertLogical) operand(x assertNode, resp *Response) (assertValue, error) {
+	v, err := x.eval(resp)
+	if err != nil {
+		return v, err
+	}
+	if v.kind != kindBool {
+		return assertValue{}, fmt.Errorf("%s applied to a %s", n.op, v.kind)
+	}
+	return v, nil
+}
+
+type assertComparison struct {
+	op   string
+	x, y assertNode
+	re   *regexp.Regexp // for ~= against a literal
+}
+
+func (n assertComparison) eval(resp *Response) (assertValue, error) {
+	x, err := n.x.eval(resp)
+	if err != nil {
+		return x, err
+	}
+	y, err := n.y.eval(resp)
+	if err != nil {
+		return y, err
+	}
+
+	if n.op == "~=" {
+		if x.kind != kindString || y.kind != kindString {
+			return assertValue{}, fmt.Errorf("~= compares strings, not a %s and a %s", x.kind, y.kind)
+		}
+		re := n.re
+		if re == nil {
+			if re, err = regexp.Compile(y.s); err != nil {
+				return assertValue{}, err
+			}
+		}
+		return assertBool(re.MatchString(x.s)), nil
+	}
+
+	if x.kind != y.kind {
+		return assertValue{}, fmt.Errorf("%s compares a %s with a %s", n.op, x.kind, y.kind)
+	}
+	switch n.op {
+	case "==":
+		return assertBool(x == y), nil
+	case "!=":
+		return assertBool(x != y), nil
+	}
+	if x.kind != kindNumber {
+		return assertValue{}, fmt.Errorf("%s compares numbers, not a %s", n.op, x.kind)
+	}
+	switch n.op {
+	case "<":
+		return assertBool(x.n < y.n), nil
+	case "<=":
+		return assertBool(x.n <= y.n), nil
+	case ">":
+		return assertBool(x.n > y.n), nil
+	default:
+		return assertBool(x.n >= y.n), nil
+	}
+}
+
+// tokens
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+// parser
+
+type assertParser struct {
+	src string
+	off int
+	tok token
+	err error // from the tokenizer
+}
+
+func (p *assertParser) errorf(format string, argv ...interface{}) error {
+	return fmt.Errorf("column %d: %s", p.tok.pos+1, fmt.Sprintf(format, argv...))
+}
+
+var assertOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "~=", "<", ">", "!", "(", ")", "[", "]"}
+
+func (p *assertParser) next() {
+	for p.off < len(p.src) && strings.IndexByte(" \t\n", p.src[p.off]) >= 0 {
+		p.off++
+	}
+	p.tok = token{pos: p.off}
+	if p.off == len(p.src) {
+		return
+	}
+
+	rest := p.src[p.off:]
+	c := rest[0]
+	switch {
+	case c == '"':
+		s, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			p.tok.kind, p.tok.text = tokOp, rest[:1]
+			p.err = p.errorf("unterminated string")
+			p.off = len(p.src)
+			return
+		}
+		p.tok.kind, p.tok.text = tokString, s
+	case c >= '0' && c <= '9' || c == '.':
+		n := strings.IndexFunc(rest, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '.')
+		})
+		if n == -1 {
+			n = len(rest)
+		}
+		p.tok.kind, p.tok.text = tokNumber, rest[:n]
+	case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		n := strings.IndexFunc(rest, func(r rune) bool {
+			return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		})
+		if n == -1 {
+			n = len(rest)
+		}
+		p.tok.kind, p.tok.text = tokIdent, rest[:n]
+	default:
+		for _, op := range assertOperators {
+			if strings.HasPrefix(rest, op) {
+				p.tok.kind, p.tok.text = tokOp, op
+				break
+			}
+		}
+		if p.tok.text == "" {
+			p.tok.kind, p.tok.text = tokOp, rest[:1]
+			p.err = p.errorf("unexpected character %q", c)
+		}
+	}
+	p.off += len(p.tok.text)
+}
+
+func (p *assertParser) is(op string) bool {
+	return p.tok.kind == tokOp && p.tok.text == op
+}
+
+func (p *assertParser) expr() (assertNode, error) {
+	x, err := p.and()
+	for err == nil && p.is("||") {
+		p.next()
+		var y assertNode
+		y, err = p.and()
+		x = assertLogical{"||", x, y}
+	}
+	return x, err
+}
+
+func (p *assertParser) and() (assertNode, error) {
+	x, err := p.unary()
+	for err == nil && p.is("&&") {
+		p.next()
+		var y assertNode
+		y, err = p.unary()
+		x = assertLogical{"&&", x, y}
+	}
+	return x, err
+}
+
+func (p *assertParser) unary() (assertNode, error) {
+	switch {
+	case p.is("!"):
+		p.next()
+		x, err := p.unary()
+		return assertNot{x}, err
+	case p.is("("):
+		p.next()
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.is(")") {
+			return nil, p.errorf("expected \")\", found %s", p.tok)
+		}
+		p.next()
+		return x, nil
+	}
+	return p.comparison()
+}
+
+func (p *assertParser) comparison() (assertNode, error) {
+	x, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op := p.tok.text; {
+	case p.tok.kind == tokOp && (op == "==" || op == "!=" || op == "<" || op == "<=" || op == ">" || op == ">=" || op == "~="):
+		p.next()
+		y, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+		c := assertComparison{op: op, x: x, y: y}
+		if lit, ok := y.(assertLiteral); ok && op == "~=" && lit.v.kind == kindString {
+			if c.re, err = regexp.Compile(lit.v.s); err != nil {
+				return nil, fmt.Errorf("invalid regular expression: %v", err)
+			}
+		}
+		return c, nil
+	}
+	return x, nil
+}
+
+func (p *assertParser) operand() (assertNode, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", tok)
+		}
+		p.next()
+		return assertLiteral{assertNumber(n)}, nil
+
+	case tokString:
+		s, _ := strconv.Unquote(tok.text)
+		p.next()
+		return assertLiteral{assertString(s)}, nil
+
+	case tokIdent:
+		switch {
+		case tok.text == "true" || tok.text == "false":
+			p.next()
+			return assertLiteral{assertBool(tok.text == "true")}, nil
+		case tok.text == "header":
+			return p.header()
+		case assertNames[tok.text]:
+			p.next()
+			return assertName(tok.text), nil
+		}
+		return nil, p.errorf("unknown name %s", tok)
+	}
+	return nil, p.errorf("expected a value, found %s", tok)
+}
+
+func (p *assertParser) header() (assertNode, error) {
+	p.next()
+	if !p.is("[") {
+		return nil, p.errorf("expected \"[\" after header, found %s", p.tok)
+	}
+	p.next()
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.tok.kind != tokString {
+		return nil, p.errorf("expected a header name, found %s", p.tok)
+	}
+	s, _ := strconv.Unquote(p.tok.text)
+	p.next()
+	if !p.is("]") {
+		return nil, p.errorf("expected \"]\", found %s", p.tok)
+	}
+	p.next()
+	return assertHeader(http.CanonicalHeaderKey(s)), nil
+}
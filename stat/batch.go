@@ -0,0 +1,163 @@
+package stat
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Limits of Tracer.Batch.
+const (
+	MaxBatchURLs            = 500
+	defaultBatchConcurrency = 8
+	maxBatchConcurrency     = 64
+)
+
+// BatchResults holds the traces of a batch of URLs.
+type BatchResults struct {
+	// Results are in the order of the URLs, repeats left out.
+	Results []BatchResult `json:"results"`
+
+	Failed   int           `json:"failed"`
+	Duration time.Duration `json:"duration"`
+
+	// BudgetExceeded is set when TotalBudget ran out before every URL
+	// was traced.
+	BudgetExceeded bool `json:"budget_exceeded"`
+}
+
+// BatchResult is the trace of one URL of a batch, Err set when it
+// failed.
+type BatchResult struct {
+	URL      string    `json:"url"`
+	Response *Response `json:"-"`
+	Err      string    `json:"error,omitempty"`
+}
+
+func (r BatchResult) String() string {
+	if r.Err != "" {
+		return fmt.Sprintf("%s failed: %s", r.URL, r.Err)
+	}
+	return fmt.Sprintf("%s %d in %s", r.URL, r.Response.StatusCode, fmtms(r.Response.Timings.Total))
+}
+
+func (b BatchResults) String() string {
+	o := []string{fmt.Sprintf("Batch: %d URLs in %s, %d failed",
+		len(b.Results), fmtms(b.Duration), b.Failed)}
+	if b.BudgetExceeded {
+		o[0] += ", budget exceeded"
+	}
+	for _, r := range b.Results {
+		o = append(o, "  "+r.String())
+	}
+	return strings.Join(o, "\n")
+}
+
+// Batch traces each of urls with the options of r, its URL aside, up to
+// r.MaxConcurrency at once (8 when zero, at most 64). Each trace is
+// bounded by the Timeout and Deadline of r, and the whole batch by
+// r.TotalBudget when set: the traces it cuts short fail, as do those it
+// leaves no time to start. A URL that is invalid or fails to trace
+// fails alone; Batch only fails on the options of r.
+func (t *Tracer) Batch(ctx context.Context, r *Request, urls []string) (*BatchResults, error) {
+	if err := t.policy().check(r); err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 || len(urls) > MaxBatchURLs {
+		return nil, invalidf("Between 1 and %d URLs can be traced in a batch", MaxBatchURLs)
+	}
+
+	if r.TotalBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.TotalBudget)
+		defer cancel()
+	}
+
+	n := r.batchConcurrency()
+
+	b := &BatchResults{}
+	seen := make(map[string]bool)
+	for _, u := range urls {
+		if !seen[u] {
+			seen[u] = true
+			b.Results = append(b.Results, BatchResult{URL: u})
+		}
+	}
+
+	start := time.Now()
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < n && i < len(b.Results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range next {
+				t.traceBatchURL(ctx, r, &b.Results[j])
+			}
+		}()
+	}
+	for j := range b.Results {
+		next <- j
+	}
+	close(next)
+	wg.Wait()
+	b.Duration = time.Since(start)
+
+	for _, res := range b.Results {
+		if res.Err != "" {
+			b.Failed++
+		}
+	}
+	b.BudgetExceeded = r.TotalBudget > 0 && ctx.Err() == context.DeadlineExceeded
+	return b, nil
+}
+
+func (r *Request) batchConcurrency() int {
+	n := r.MaxConcurrency
+	if n <= 0 {
+		n = defaultBatchConcurrency
+	}
+	if n > maxBatchConcurrency {
+		n = maxBatchConcurrency
+	}
+	return n
+}
+
+// BatchTraces returns how many traces Batch runs at once at most for r
+// over urls URLs.
+func (r *Request) BatchTraces(urls int) int {
+	n := r.batchConcurrency()
+	if n > urls {
+		n = urls
+	}
+	return n * r.Traces()
+}
+
+// traceBatchURL traces res.URL with the options of r into res.
+func (t *Tracer) traceBatchURL(ctx context.Context, r *Request, res *BatchResult) {
+	switch ctx.Err() {
+	case nil:
+	case context.DeadlineExceeded:
+		res.Err = "Not traced, the batch ran out of time"
+		return
+	default:
+		res.Err = "Not traced, the batch was canceled"
+		return
+	}
+	u, err := parseURL(res.URL)
+	if err != nil {
+		res.Err = err.Error()
+		return
+	}
+	req := *r
+	req.URL = u
+	resp, err := t.Trace(ctx, &req)
+	if err != nil {
+		res.Err = err.Error()
+		return
+	}
+	res.Response = resp
+}
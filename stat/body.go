@@ -0,0 +1,110 @@
+package stat
+
+import (
+	"encoding/base64"
+	"io"
+	"unicode/utf8"
+)
+
+// Body is the captured content of a response body.
+type Body struct {
+	Content string `json:"content"`
+
+	// Encoding is "base64" when the content is not valid UTF-8,
+	// empty otherwise.
+	Encoding string `json:"encoding,omitempty"`
+
+	// Size is the number of bytes read from the wire, Truncated is set
+	// when that is more than what was captured.
+	Size      int64 `json:"size"`
+	Truncated bool  `json:"truncated"`
+
+	// Tail is set when the content is the end of the body rather than
+	// its start.
+	Tail bool `json:"tail,omitempty"`
+}
+
+func newBody(b []byte, size int64, tail bool) *Body {
+	body := &Body{
+		Size:      size,
+		Truncated: int64(len(b)) < size,
+		Tail:      tail,
+	}
+
+	// a truncated body may end, or for its tail start, in the middle
+	// of a character
+	text := b
+	for i := 0; body.Truncated && i < utf8.UTFMax-1 && !utf8.Valid(text); i++ {
+		if tail {
+			text = text[1:]
+		} else {
+			text = text[:len(text)-1]
+		}
+	}
+	if utf8.Valid(text) {
+		body.Content = string(text)
+	} else {
+		body.Content = base64.StdEncoding.EncodeToString(b)
+		body.Encoding = "base64"
+	}
+	return body
+}
+
+// ringWriter keeps the last len(buf) bytes written to it.
+type ringWriter struct {
+	buf  []byte
+	pos  int
+	full bool
+}
+
+func newRingWriter(n int64) *ringWriter {
+	return &ringWriter{buf: make([]byte, n)}
+}
+
+func (r *ringWriter) Write(p []byte) (int, error) {
+	size := len(p)
+	if len(p) > len(r.buf) {
+		p = p[len(p)-len(r.buf):]
+	}
+	n := copy(r.buf[r.pos:], p)
+	if n < len(p) {
+		r.full = true
+		r.pos = copy(r.buf, p[n:])
+	} else {
+		r.pos += n
+		if r.pos == len(r.buf) {
+			r.full, r.pos = true, 0
+		}
+	}
+	return size, nil
+}
+
+// Bytes returns what was kept, oldest first.
+func (r *ringWriter) Bytes() []byte {
+	if !r.full {
+		return r.buf[:r.pos]
+	}
+	return append(append([]byte(nil), r.buf[r.pos:]...), r.buf[:r.pos]...)
+}
+
+// limitedWriter writes at most n bytes to w and silently drops the rest.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	size := len(p)
+	if l.n <= 0 {
+		return size, nil
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.w.Write(p)
+	l.n -= int64(n)
+	if err != nil {
+		return n, err
+	}
+	return size, nil
+}
@@ -0,0 +1,96 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CacheInfo tells which parts of the request caches key the response
+// on: the URL with its query parameters, and the request headers named
+// by Vary.
+type CacheInfo struct {
+	// Vary lists the request headers the response varies on, with
+	// their value in the request.
+	Vary []CacheKeyHeader `json:"vary"`
+
+	// VaryAll is set for Vary: *, which no cache can match: the
+	// response is effectively uncacheable by shared caches.
+	VaryAll bool `json:"vary_all"`
+
+	// QueryParams are the names of the query parameters of the URL,
+	// each value of which makes a distinct cache entry.
+	QueryParams []string `json:"query_params,omitempty"`
+
+	// Cookies are the names of the cookies sent, part of the key when
+	// the response varies on Cookie.
+	Cookies []string `json:"cookies,omitempty"`
+}
+
+// CacheKeyHeader is a request header part of the cache key.
+type CacheKeyHeader struct {
+	Name string `json:"name"`
+
+	// Value is what the request set, Sent is unset when it set none;
+	// the transport still sends its default User-Agent then.
+	Value string `json:"value"`
+	Sent  bool   `json:"sent"`
+}
+
+func newCacheInfo(req *http.Request, u *url.URL, h http.Header) *CacheInfo {
+	c := &CacheInfo{}
+
+	seen := make(map[string]bool)
+	for _, v := range h["Vary"] {
+		for _, name := range strings.Split(v, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			switch {
+			case name == "":
+			case name == "*":
+				c.VaryAll = true
+			case !seen[name]:
+				seen[name] = true
+				_, sent := req.Header[name]
+				c.Vary = append(c.Vary, CacheKeyHeader{name, req.Header.Get(name), sent})
+			}
+		}
+	}
+
+	for k := range u.Query() {
+		c.QueryParams = append(c.QueryParams, k)
+	}
+	sort.Strings(c.QueryParams)
+
+	if seen["Cookie"] {
+		for _, ck := range req.Cookies() {
+			c.Cookies = append(c.Cookies, ck.Name)
+		}
+	}
+	return c
+}
+
+func (c CacheInfo) String() string {
+	if c.VaryAll {
+		return "varies on everything (Vary: *), uncacheable by shared caches"
+	}
+
+	var o []string
+	if len(c.Vary) == 0 {
+		o = append(o, "varies on no header")
+	} else {
+		names := make([]string, len(c.Vary))
+		for i, v := range c.Vary {
+			names[i] = v.Name
+		}
+		o = append(o, "varies on: "+strings.Join(names, ", "))
+	}
+	if len(c.QueryParams) > 0 {
+		o = append(o, fmt.Sprintf("query parameters %s", strings.Join(c.QueryParams, ", ")))
+	}
+	if len(c.Cookies) > 0 {
+		o = append(o, fmt.Sprintf("cookies %s", strings.Join(c.Cookies, ", ")))
+	}
+	return strings.Join(o, "; ")
+}
@@ -0,0 +1,57 @@
+package stat
+
+import (
+	"golang.org/x/net/context"
+)
+
+// ChainAttempt is a run through the redirect chain that failed, for
+// Request.Retries.
+type ChainAttempt struct {
+	// Hops is how many hops completed before the failure.
+	Hops int    `json:"hops"`
+	Err  string `json:"error"`
+}
+
+// visitChain visits r and the redirects it leads to, starting over from
+// the first hop up to r.Retries times when one fails. Each run starts
+// from what w held before it, so nothing carries over from a failed run
+// but the report of its failure.
+func (r Request) visitChain(ctx context.Context, t *Tracer, w *Response) error {
+	if r.Retries <= 0 {
+		return r.visit(ctx, t, w)
+	}
+
+	base := *w
+	for attempt := 1; ; attempt++ {
+		run := base
+		run.Log = append([]string(nil), base.Log...)
+		run.Warnings = append([]Warning(nil), base.Warnings...)
+
+		err := r.visit(ctx, t, &run)
+		if err == nil {
+			*w = run
+			return nil
+		}
+		if attempt > r.Retries || ctx.Err() != nil || !transient(err) {
+			return err
+		}
+
+		a := ChainAttempt{Hops: len(run.Hops), Err: err.Error()}
+		base.Attempts = append(base.Attempts, a)
+		base.report("Attempt %d failed after %d hops: %s, starting over\n", attempt, a.Hops, a.Err)
+	}
+}
+
+// transient reports whether err, what a run failed with, is a failure
+// of the network that a new run may not meet.
+func transient(err error) bool {
+	te, ok := err.(*TraceError)
+	if !ok {
+		return false
+	}
+	switch te.Kind {
+	case ErrorDNS, ErrorConnect, ErrorTLS, ErrorTimeout:
+		return true
+	}
+	return false
+}
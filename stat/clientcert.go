@@ -0,0 +1,51 @@
+package stat
+
+import (
+	"crypto/tls"
+	"sync/atomic"
+
+	"golang.org/x/net/context"
+)
+
+// certRequest records whether the server asked for a client certificate
+// during the handshakes of a single trace.
+type certRequest struct {
+	requested int32 // accessed atomically
+	sent      int32
+}
+
+type certRequestKey struct{}
+
+func withCertRequest(ctx context.Context, cr *certRequest) context.Context {
+	return context.WithValue(ctx, certRequestKey{}, cr)
+}
+
+// clientCertificate returns a tls.Config.GetClientCertificate callback
+// offering certs, if any, whenever the server asks for a certificate:
+// in the initial handshake, or when it renegotiates a TLS 1.2 session.
+//
+// Initial handshakes run with the context of the trace, which tells
+// which trace the request belongs to. Renegotiations run without one,
+// so they are only counted on the transport t.
+//
+// crypto/tls does not implement TLS 1.3 post-handshake authentication;
+// servers relying on it never see a certificate.
+func (t *transport) clientCertificate(certs []tls.Certificate) func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return func(cri *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cr, _ := cri.Context().Value(certRequestKey{}).(*certRequest)
+		if cr == nil {
+			atomic.AddInt32(&t.renegotiations, 1)
+		} else {
+			atomic.StoreInt32(&cr.requested, 1)
+		}
+
+		if len(certs) == 0 {
+			// no certificate to send
+			return &tls.Certificate{}, nil
+		}
+		if cr != nil {
+			atomic.StoreInt32(&cr.sent, 1)
+		}
+		return &certs[0], nil
+	}
+}
@@ -0,0 +1,89 @@
+package stat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A Comparison puts two traces of the same request side by side.
+type Comparison struct {
+	Labels    [2]string
+	Responses [2]*Response
+	Errs      [2]error
+}
+
+// PhaseDiff compares a single phase of two traces.
+type PhaseDiff struct {
+	Phase string
+	A, B  time.Duration
+}
+
+// Diff returns how much longer the phase took in B than in A.
+func (d PhaseDiff) Diff() time.Duration {
+	return d.B - d.A
+}
+
+// phases lists the phases of t in order, with their names.
+func phases(t Timings) []struct {
+	Name string
+	D    time.Duration
+} {
+	return []struct {
+		Name string
+		D    time.Duration
+	}{
+		{"DNS lookup", t.DNSLookup},
+		{"TCP connection", t.TCPConnection},
+		{"TLS handshake", t.TLSHandshake},
+		{"Server processing", t.ServerProcessing},
+		{"Content transfer", t.ContentTransfer},
+		{"Total", t.Total},
+	}
+}
+
+// Diffs compares the phases of both traces, nil unless both succeeded.
+func (c Comparison) Diffs() []PhaseDiff {
+	if c.Errs[0] != nil || c.Errs[1] != nil {
+		return nil
+	}
+
+	a, b := phases(c.Responses[0].Timings), phases(c.Responses[1].Timings)
+	diffs := make([]PhaseDiff, len(a))
+	for i := range a {
+		diffs[i] = PhaseDiff{a[i].Name, a[i].D, b[i].D}
+	}
+	return diffs
+}
+
+func (c Comparison) String() string {
+	var o []string
+	if c.Errs[0] != nil || c.Errs[1] != nil {
+		// no diff, but the timings of a trace that succeeded still tell
+		for i, err := range c.Errs {
+			if err != nil {
+				o = append(o, fmt.Sprintf("%s: unavailable: %v", c.Labels[i], err))
+				continue
+			}
+			o = append(o, c.Labels[i]+":")
+			for _, p := range phases(c.Responses[i].Timings) {
+				o = append(o, fmt.Sprintf("  %-18s %10s", p.Name+":", fmtms(p.D)))
+			}
+		}
+		return strings.Join(o, "\n")
+	}
+
+	o = append(o, fmt.Sprintf("%-18s %10s %10s %10s", "", c.Labels[0], c.Labels[1], "diff"))
+	for _, d := range c.Diffs() {
+		o = append(o, fmt.Sprintf("%-18s %10s %10s %10s",
+			d.Phase+":", fmtms(d.A), fmtms(d.B), fmtDiff(d.Diff())))
+	}
+	return strings.Join(o, "\n")
+}
+
+func fmtDiff(d time.Duration) string {
+	if d > 0 {
+		return "+" + fmtms(d)
+	}
+	return fmtms(d)
+}
@@ -0,0 +1,112 @@
+package stat
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+// TLSRequirements lists what a negotiated TLS connection must meet.
+// Zero fields are not checked.
+type TLSRequirements struct {
+	// MinVersion is the lowest acceptable protocol version, e.g. tls.VersionTLS12.
+	MinVersion uint16
+
+	// ForwardSecrecy requires an ephemeral key exchange (ECDHE or DHE).
+	ForwardSecrecy bool
+
+	// Curve requires a specific key exchange group, e.g. tls.X25519.
+	Curve tls.CurveID
+}
+
+func (tr TLSRequirements) isZero() bool {
+	return tr == TLSRequirements{}
+}
+
+// TLSCompliance is the outcome of checking a connection against
+// TLSRequirements.
+type TLSCompliance struct {
+	Compliant bool       `json:"compliant"`
+	Checks    []TLSCheck `json:"checks"`
+}
+
+// TLSCheck is the outcome of a single requirement.
+type TLSCheck struct {
+	Name   string `json:"name"`
+	Pass   bool   `json:"pass"`
+	Detail string `json:"detail"`
+}
+
+func checkTLS(tr TLSRequirements, cs *tls.ConnectionState) *TLSCompliance {
+	c := &TLSCompliance{Compliant: true}
+	check := func(name string, pass bool, format string, argv ...interface{}) {
+		c.Checks = append(c.Checks, TLSCheck{name, pass, fmt.Sprintf(format, argv...)})
+		c.Compliant = c.Compliant && pass
+	}
+
+	if cs == nil {
+		check("tls", false, "connection does not use TLS")
+		return c
+	}
+
+	if tr.MinVersion != 0 {
+		check("min-version", cs.Version >= tr.MinVersion, "negotiated %s, required %s",
+			tlsVersionName(cs.Version), tlsVersionName(tr.MinVersion))
+	}
+	if tr.ForwardSecrecy {
+		check("forward-secrecy", forwardSecret(cs), "cipher suite %s",
+			tls.CipherSuiteName(cs.CipherSuite))
+	}
+	if tr.Curve != 0 {
+		if cs.CurveID == 0 {
+			check("curve", false, "key exchange group could not be determined, required %s", tr.Curve)
+		} else {
+			check("curve", cs.CurveID == tr.Curve, "negotiated %s, required %s", cs.CurveID, tr.Curve)
+		}
+	}
+	return c
+}
+
+func (c TLSCompliance) String() string {
+	verdict := "yes"
+	if !c.Compliant {
+		verdict = "no"
+	}
+
+	o := []string{"TLS compliant: " + verdict}
+	for _, v := range c.Checks {
+		result := "pass"
+		if !v.Pass {
+			result = "FAIL"
+		}
+		o = append(o, fmt.Sprintf("  %s: %s (%s)", v.Name, result, v.Detail))
+	}
+	return strings.Join(o, "\n")
+}
+
+// forwardSecret reports whether the key exchange of cs was ephemeral.
+func forwardSecret(cs *tls.ConnectionState) bool {
+	if cs.Version >= tls.VersionTLS13 {
+		// every TLS 1.3 key exchange is ephemeral
+		return true
+	}
+	name := tls.CipherSuiteName(cs.CipherSuite)
+	return strings.HasPrefix(name, "TLS_ECDHE_") || strings.HasPrefix(name, "TLS_DHE_")
+}
+
+func tlsVersionName(v uint16) string {
+	switch v {
+	case tls.VersionSSL30:
+		return "SSL 3.0"
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return fmt.Sprintf("0x%04x", v)
+	}
+}
@@ -0,0 +1,114 @@
+package stat
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Compression tells how much content coding shrank a response body.
+type Compression struct {
+	Encoding string `json:"encoding"`
+
+	// bytes on the wire and once decoded
+	Compressed   int64 `json:"compressed"`
+	Decompressed int64 `json:"decompressed"`
+
+	// Ratio is Compressed over Decompressed, Ineffective is set when
+	// it is so close to 1 the server would better not compress.
+	Ratio       float64 `json:"ratio"`
+	Ineffective bool    `json:"ineffective"`
+}
+
+// Compressing bodies smaller than minCompressible is not expected to
+// pay off; above it, a ratio over maxEffectiveRatio is ineffective.
+const (
+	minCompressible   = 1 << 10
+	maxEffectiveRatio = 0.95
+)
+
+func newCompression(encoding string, compressed, decompressed int64) *Compression {
+	c := &Compression{
+		Encoding:     encoding,
+		Compressed:   compressed,
+		Decompressed: decompressed,
+	}
+	if decompressed > 0 {
+		c.Ratio = float64(compressed) / float64(decompressed)
+		c.Ineffective = decompressed >= minCompressible && c.Ratio > maxEffectiveRatio
+	}
+	return c
+}
+
+func (c Compression) String() string {
+	return fmt.Sprintf("%s, %d bytes to %d, ratio %.2f", c.Encoding, c.Compressed, c.Decompressed, c.Ratio)
+}
+
+// wantsCompression reports whether req should ask for a gzip body. The
+// transport would ask on its own, but then decompresses the body
+// without telling how large it was on the wire.
+func wantsCompression(req *http.Request) bool {
+	return req.Method != http.MethodHead &&
+		req.Header.Get("Accept-Encoding") == "" &&
+		req.Header.Get("Range") == ""
+}
+
+// decodingBody decodes a gzip or deflate body, counting the bytes read
+// from the wire.
+type decodingBody struct {
+	body     io.ReadCloser
+	wire     countingReader
+	encoding string
+	r        io.Reader
+}
+
+// decodeBody replaces the body of resp with one decoding its content
+// coding, and returns it. It returns nil for encodings it cannot
+// decode, leaving the body alone.
+func decodeBody(resp *http.Response) *decodingBody {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if encoding != "gzip" && encoding != "deflate" {
+		return nil
+	}
+	d := &decodingBody{body: resp.Body, wire: countingReader{r: resp.Body}, encoding: encoding}
+	resp.Body = d
+	return d
+}
+
+// Read sets up the decoder on first use, as bodies may be empty.
+func (d *decodingBody) Read(p []byte) (int, error) {
+	if d.r == nil {
+		var err error
+		if d.encoding == "gzip" {
+			d.r, err = gzip.NewReader(&d.wire)
+		} else {
+			d.r, err = zlib.NewReader(&d.wire)
+		}
+		if err == io.EOF {
+			d.r = strings.NewReader("")
+		} else if err != nil {
+			d.r = nil
+			return 0, fmt.Errorf("invalid %s body: %v", d.encoding, err)
+		}
+	}
+	return d.r.Read(p)
+}
+
+func (d *decodingBody) Close() error {
+	return d.body.Close()
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
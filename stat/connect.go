@@ -0,0 +1,137 @@
+package stat
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Stages at which a connection attempt can fail.
+const (
+	StageDNS     = "dns"
+	StageConnect = "connect"
+	StageTLS     = "tls"
+)
+
+// ConnectProbe summarizes repeated attempts at connecting to a host,
+// DNS lookup, TCP connection and TLS handshake included, without
+// sending any request.
+type ConnectProbe struct {
+	Attempts int
+
+	// Durations of the successful attempts, in order.
+	Durations []time.Duration
+
+	// Failures counts failed attempts by stage, Errors keeps the last
+	// error seen at each.
+	Failures map[string]int
+	Errors   map[string]error
+}
+
+// SuccessRate returns the share of attempts that connected, from 0 to 1.
+func (p ConnectProbe) SuccessRate() float64 {
+	if p.Attempts == 0 {
+		return 0
+	}
+	return float64(len(p.Durations)) / float64(p.Attempts)
+}
+
+// ProbeConnections connects to the host of r n times, one attempt after
+// the other, closing each connection right away. Connections are not
+// pooled, but the policy of t applies.
+func (t *Tracer) ProbeConnections(ctx context.Context, r *Request, n int) (*ConnectProbe, error) {
+	policy := t.policy()
+	if err := policy.check(r); err != nil {
+		return nil, err
+	}
+
+	p := &ConnectProbe{
+		Failures: make(map[string]int),
+		Errors:   make(map[string]error),
+	}
+
+	for i := 0; i < n && ctx.Err() == nil; i++ {
+		p.Attempts++
+
+		start := time.Now()
+		stage, err := r.connectOnce(ctx, policy)
+		if err != nil {
+			p.Failures[stage]++
+			p.Errors[stage] = err
+			continue
+		}
+		p.Durations = append(p.Durations, time.Since(start))
+	}
+	return p, nil
+}
+
+// connectOnce resolves, dials and, for https, handshakes once. It
+// returns the stage that failed, if any.
+func (r *Request) connectOnce(ctx context.Context, policy *Policy) (string, error) {
+	host := r.URL.Hostname()
+	ips, err := net.DefaultResolver.LookupIP(ctx, r.lookupNetwork(), host)
+	if err != nil {
+		return StageDNS, err
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	if !policy.AllowPrivateTargets {
+		dialer.Control = controlDial
+	}
+
+	addr := net.JoinHostPort(ips[0].String(), portOf(r.URL))
+	conn, err := dialer.DialContext(ctx, r.network(), addr)
+	if err != nil {
+		return StageConnect, err
+	}
+	defer conn.Close()
+
+	if r.URL.Scheme != "https" {
+		return "", nil
+	}
+
+	tc := tls.Client(conn, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: r.Insecure,
+	})
+	if err := tc.HandshakeContext(ctx); err != nil {
+		return StageTLS, err
+	}
+	return "", nil
+}
+
+// lookupNetwork returns the network to resolve the host of r on, for
+// the addresses its connections may use.
+func (r *Request) lookupNetwork() string {
+	switch r.network() {
+	case "tcp4":
+		return "ip4"
+	case "tcp6":
+		return "ip6"
+	}
+	return "ip"
+}
+
+func (p ConnectProbe) String() string {
+	o := []string{fmt.Sprintf("Connections: %d/%d succeeded (%.0f%%)",
+		len(p.Durations), p.Attempts, 100*p.SuccessRate())}
+
+	for _, stage := range []string{StageDNS, StageConnect, StageTLS} {
+		if n := p.Failures[stage]; n > 0 {
+			o = append(o, fmt.Sprintf("Failed at %s: %d (last error: %v)", stage, n, p.Errors[stage]))
+		}
+	}
+
+	if len(p.Durations) > 0 {
+		d := append([]time.Duration(nil), p.Durations...)
+		sort.Sort(durations(d))
+		o = append(o, fmt.Sprintf("Connect time: min %s, median %s, max %s",
+			fmtms(d[0]), fmtms(d[len(d)/2]), fmtms(d[len(d)-1])))
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,35 @@
+package stat
+
+import "strings"
+
+// validateCookies checks that v is a "k=v; k2=v2" Cookie header value.
+// Errors name the offending pair by position, never by content, as
+// cookie values are usually secrets.
+func validateCookies(v string) error {
+	for i, pair := range strings.Split(v, ";") {
+		pair = strings.TrimSpace(pair)
+		j := strings.Index(pair, "=")
+		if j < 1 {
+			return invalidf("Cookie %d is invalid, expected name=value", i+1)
+		}
+
+		name, value := pair[:j], strings.Trim(pair[j+1:], `"`)
+		if strings.IndexFunc(name, notTokenChar) != -1 {
+			return invalidf("Cookie %d has an invalid name", i+1)
+		}
+		if strings.IndexFunc(value, notCookieChar) != -1 {
+			return invalidf("Cookie %d has an invalid value", i+1)
+		}
+	}
+	return nil
+}
+
+// notTokenChar reports whether c cannot appear in an RFC 7230 token.
+func notTokenChar(c rune) bool {
+	return c <= ' ' || c >= 0x7f || strings.ContainsRune(`()<>@,;:\"/[]?={}`, c)
+}
+
+// notCookieChar reports whether c cannot appear in an RFC 6265 cookie value.
+func notCookieChar(c rune) bool {
+	return c <= ' ' || c >= 0x7f || c == '"' || c == ',' || c == ';' || c == '\\'
+}
@@ -0,0 +1,238 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// CORS describes how a response answers a cross-origin request, and
+// whether a browser would let the requesting origin read it.
+type CORS struct {
+	Origin string `json:"origin"`
+
+	AllowOrigin      string `json:"allow_origin"`
+	AllowCredentials bool   `json:"allow_credentials"`
+	AllowMethods     string `json:"allow_methods,omitempty"`
+	AllowHeaders     string `json:"allow_headers,omitempty"`
+	ExposeHeaders    string `json:"expose_headers,omitempty"`
+	MaxAge           string `json:"max_age,omitempty"`
+
+	// VaryOrigin is set when the response varies on Origin, which
+	// caches need when the allowed origin is echoed back.
+	VaryOrigin bool `json:"vary_origin"`
+
+	// Allowed tells whether the origin may read the response, Reason
+	// why not.
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// checkCORS applies the CORS check browsers make to the response of a
+// request from origin; credentials is whether the request carried
+// cookies or authorization.
+func checkCORS(origin string, credentials bool, h http.Header) *CORS {
+	c := &CORS{
+		Origin:           origin,
+		AllowOrigin:      h.Get("Access-Control-Allow-Origin"),
+		AllowCredentials: h.Get("Access-Control-Allow-Credentials") == "true",
+		AllowMethods:     h.Get("Access-Control-Allow-Methods"),
+		AllowHeaders:     h.Get("Access-Control-Allow-Headers"),
+		ExposeHeaders:    h.Get("Access-Control-Expose-Headers"),
+		MaxAge:           h.Get("Access-Control-Max-Age"),
+		VaryOrigin:       headerHasToken(h, "Vary", "origin") || headerHasToken(h, "Vary", "*"),
+	}
+
+	switch n := len(h["Access-Control-Allow-Origin"]); {
+	case n == 0:
+		c.Reason = "no Access-Control-Allow-Origin header"
+	case n > 1 || strings.Contains(c.AllowOrigin, ","):
+		c.Reason = "Access-Control-Allow-Origin holds more than one origin"
+	case c.AllowOrigin == "*" && credentials:
+		c.Reason = "wildcard Access-Control-Allow-Origin on a request with credentials"
+	case c.AllowOrigin == "*":
+		c.Allowed = true
+	case c.AllowOrigin != origin:
+		c.Reason = fmt.Sprintf("Access-Control-Allow-Origin is %s", c.AllowOrigin)
+	case credentials && !c.AllowCredentials:
+		c.Reason = "Access-Control-Allow-Credentials is not true on a request with credentials"
+	default:
+		c.Allowed = true
+	}
+	return c
+}
+
+func (c *CORS) String() string {
+	var o string
+	if c.Allowed {
+		o = fmt.Sprintf("origin %s allowed", c.Origin)
+	} else {
+		o = fmt.Sprintf("origin %s not allowed, %s", c.Origin, c.Reason)
+	}
+
+	var params []string
+	for _, p := range []struct{ name, value string }{
+		{"methods", c.AllowMethods},
+		{"headers", c.AllowHeaders},
+		{"expose", c.ExposeHeaders},
+		{"max-age", c.MaxAge},
+	} {
+		if p.value != "" {
+			params = append(params, p.name+"="+p.value)
+		}
+	}
+	if c.AllowCredentials {
+		params = append(params, "credentials")
+	}
+	if len(params) == 0 {
+		return o
+	}
+	return o + " (" + strings.Join(params, ", ") + ")"
+}
+
+// Preflight is the outcome of the CORS preflight a browser would send
+// before the traced request.
+type Preflight struct {
+	StatusCode int     `json:"status_code"`
+	Timings    Timings `json:"timings"`
+
+	// Method and Headers are what the preflight asked for.
+	Method  string   `json:"method"`
+	Headers []string `json:"headers,omitempty"`
+
+	CORS *CORS `json:"cors,omitempty"`
+
+	// Required is set when a browser would send the preflight at all,
+	// the request not being a simple one.
+	Required bool `json:"required"`
+
+	// Blocked is set when the preflight would stop the browser from
+	// sending the request, Reason tells why.
+	Blocked bool   `json:"blocked"`
+	Reason  string `json:"reason,omitempty"`
+
+	// Err is why the preflight request failed.
+	Err string `json:"error,omitempty"`
+
+	// Response is the full trace of the preflight.
+	Response *Response `json:"-"`
+}
+
+func (p *Preflight) String() string {
+	o := "Preflight: OPTIONS"
+	if p.Err != "" {
+		return fmt.Sprintf("%s failed: %s", o, p.Err)
+	}
+	o = fmt.Sprintf("%s %d in %s", o, p.StatusCode, fmtms(p.Timings.Total))
+	if !p.Required {
+		o += ", not required for this request"
+	}
+	if p.Blocked {
+		return fmt.Sprintf("%s, blocks %s: %s", o, p.Method, p.Reason)
+	}
+	return fmt.Sprintf("%s, allows %s: %s", o, p.Method, p.CORS)
+}
+
+// preflight traces the OPTIONS request a browser sends from origin
+// before r, and checks whether its answer lets r through.
+func (t *Tracer) preflight(ctx context.Context, r *Request, origin string) *Preflight {
+	p := &Preflight{Method: r.HTTPMethod}
+
+	pre := *r
+	pre.HTTPMethod = "OPTIONS"
+	pre.PostBody = ""
+	pre.Cookies = ""
+	pre.BasicAuth, pre.BearerToken = "", ""
+	pre.FollowRedirects = false
+	pre.Preflight, pre.PreflightOnly = false, false
+	pre.Shadow = ""
+	pre.Assertions = nil
+	pre.AuditHeaders = false
+
+	// the preflight carries none of the headers of the request, nor
+	// its credentials
+	credentials := r.Cookies != "" || r.BasicAuth != "" || r.BearerToken != ""
+	pre.HTTPHeaders = Headers{"Origin: " + origin, "Access-Control-Request-Method: " + r.HTTPMethod}
+	for _, h := range r.HTTPHeaders {
+		k, v := headerKeyValue(h)
+		switch {
+		case strings.EqualFold(k, "Cookie"), strings.EqualFold(k, "Authorization"):
+			credentials = true
+		case strings.EqualFold(k, "Host"):
+			pre.HTTPHeaders = append(pre.HTTPHeaders, h)
+		}
+		if !safelistedHeader(k, v) {
+			p.Headers = append(p.Headers, strings.ToLower(k))
+		}
+	}
+	if len(p.Headers) > 0 {
+		sort.Strings(p.Headers)
+		pre.HTTPHeaders = append(pre.HTTPHeaders, "Access-Control-Request-Headers: "+strings.Join(p.Headers, ","))
+	}
+	p.Required = len(p.Headers) > 0 || !simpleMethod(r.HTTPMethod)
+
+	resp, err := t.Trace(ctx, &pre)
+	if err != nil {
+		p.Err = err.Error()
+		p.Blocked = true
+		p.Reason = "preflight failed"
+		return p
+	}
+	p.Response = resp
+	p.StatusCode = resp.StatusCode
+	p.Timings = resp.Timings
+	p.CORS = checkCORS(origin, credentials, resp.Header)
+
+	switch {
+	case resp.StatusCode/100 != 2:
+		p.Reason = fmt.Sprintf("status %d", resp.StatusCode)
+	case !p.CORS.Allowed:
+		p.Reason = p.CORS.Reason
+	case !simpleMethod(r.HTTPMethod) && !corsListAllows(p.CORS.AllowMethods, r.HTTPMethod, credentials):
+		p.Reason = fmt.Sprintf("method %s not in Access-Control-Allow-Methods", r.HTTPMethod)
+	default:
+		for _, h := range p.Headers {
+			wildcard := !credentials && h != "authorization"
+			if !corsListAllows(p.CORS.AllowHeaders, h, !wildcard) {
+				p.Reason = fmt.Sprintf("header %s not in Access-Control-Allow-Headers", h)
+				break
+			}
+		}
+	}
+	p.Blocked = p.Reason != ""
+	return p
+}
+
+// corsListAllows reports whether the comma separated list allows v,
+// case-insensitively; "*" counts unless exact is set.
+func corsListAllows(list, v string, exact bool) bool {
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if strings.EqualFold(item, v) || item == "*" && !exact {
+			return true
+		}
+	}
+	return false
+}
+
+func simpleMethod(m string) bool {
+	return m == "GET" || m == "HEAD" || m == "POST"
+}
+
+// safelistedHeader reports whether browsers send header k: v without
+// a preflight, or set it themselves.
+func safelistedHeader(k, v string) bool {
+	switch strings.ToLower(k) {
+	case "accept", "accept-language", "content-language", "range":
+		return true
+	case "content-type":
+		mt := strings.ToLower(strings.TrimSpace(strings.SplitN(v, ";", 2)[0]))
+		return mt == "application/x-www-form-urlencoded" || mt == "multipart/form-data" || mt == "text/plain"
+	case "cookie", "host", "origin", "referer", "user-agent", "connection", "content-length", "accept-encoding":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Verdicts of CSP.Strength.
+const (
+	CSPNone     = "none"
+	CSPWeak     = "weak"
+	CSPModerate = "moderate"
+	CSPStrong   = "strong"
+)
+
+// CSP describes the Content-Security-Policy of a response.
+type CSP struct {
+	// policies enforced, from Content-Security-Policy, and only
+	// reported, from Content-Security-Policy-Report-Only
+	Policies   []CSPPolicy `json:"policies"`
+	ReportOnly []CSPPolicy `json:"report_only"`
+
+	// Strength is the verdict on the enforced policies, one of the CSP
+	// constants: none without a policy, weak when scripts are not
+	// restricted or unsafely so, moderate when plugins or base URLs
+	// are left open, strong otherwise.
+	Strength string `json:"strength"`
+}
+
+// CSPPolicy is a single policy, a header may hold several separated by
+// commas.
+type CSPPolicy struct {
+	Directives []CSPDirective `json:"directives"`
+
+	// Unsafe lists the unsafe sources found, as "directive source".
+	Unsafe []string `json:"unsafe,omitempty"`
+
+	Strength string `json:"strength"`
+}
+
+// CSPDirective is a directive and its sources.
+type CSPDirective struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values,omitempty"`
+}
+
+// Present reports whether the response enforces a policy.
+func (c CSP) Present() bool {
+	return len(c.Policies) > 0
+}
+
+func newCSP(h http.Header) *CSP {
+	c := &CSP{Strength: CSPNone}
+	for _, v := range h["Content-Security-Policy"] {
+		c.Policies = append(c.Policies, parseCSP(v)...)
+	}
+	for _, v := range h["Content-Security-Policy-Report-Only"] {
+		c.ReportOnly = append(c.ReportOnly, parseCSP(v)...)
+	}
+
+	// content has to pass every policy enforced, the strongest wins
+	for _, p := range c.Policies {
+		if cspRank(p.Strength) > cspRank(c.Strength) {
+			c.Strength = p.Strength
+		}
+	}
+	return c
+}
+
+func cspRank(strength string) int {
+	switch strength {
+	case CSPWeak:
+		return 1
+	case CSPModerate:
+		return 2
+	case CSPStrong:
+		return 3
+	}
+	return 0
+}
+
+// parseCSP parses the policies of a header value. Directive names are
+// case-insensitive, a repeated directive is ignored as browsers do.
+func parseCSP(v string) []CSPPolicy {
+	var policies []CSPPolicy
+	for _, serialized := range strings.Split(v, ",") {
+		p := CSPPolicy{}
+		seen := make(map[string]bool)
+		for _, d := range strings.Split(serialized, ";") {
+			fields := strings.Fields(d)
+			if len(fields) == 0 {
+				continue
+			}
+			name := strings.ToLower(fields[0])
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			p.Directives = append(p.Directives, CSPDirective{name, fields[1:]})
+		}
+		if len(p.Directives) == 0 {
+			continue
+		}
+		p.assess()
+		policies = append(policies, p)
+	}
+	return policies
+}
+
+func (p *CSPPolicy) directive(name string) *CSPDirective {
+	for i := range p.Directives {
+		if p.Directives[i].Name == name {
+			return &p.Directives[i]
+		}
+	}
+	return nil
+}
+
+// fetchDirectives are the directives whose sources are checked for
+// unsafe values.
+var fetchDirectives = map[string]bool{
+	"default-src": true, "script-src": true, "script-src-elem": true,
+	"script-src-attr": true, "style-src": true, "object-src": true,
+}
+
+// assess flags unsafe sources and rates p.
+func (p *CSPPolicy) assess() {
+	for _, d := range p.Directives {
+		if !fetchDirectives[d.Name] {
+			continue
+		}
+		nonced := false
+		for _, v := range d.Values {
+			lv := strings.ToLower(v)
+			if strings.HasPrefix(lv, "'nonce-") || strings.HasPrefix(lv, "'sha") || lv == "'strict-dynamic'" {
+				nonced = true
+			}
+		}
+		for _, v := range d.Values {
+			switch lv := strings.ToLower(v); {
+			case lv == "'unsafe-inline'" && nonced:
+				// ignored by browsers when a nonce or hash is given
+			case lv == "'unsafe-inline'", lv == "'unsafe-eval'", lv == "*",
+				lv == "http:", lv == "https:", lv == "data:":
+				p.Unsafe = append(p.Unsafe, d.Name+" "+v)
+			}
+		}
+	}
+
+	scripts := p.directive("script-src")
+	if scripts == nil {
+		scripts = p.directive("default-src")
+	}
+	objects := p.directive("object-src")
+	if objects == nil {
+		objects = p.directive("default-src")
+	}
+
+	switch {
+	case scripts == nil || p.unsafeFor(scripts.Name):
+		p.Strength = CSPWeak
+	case objects == nil || p.directive("base-uri") == nil:
+		p.Strength = CSPModerate
+	default:
+		p.Strength = CSPStrong
+	}
+}
+
+func (p *CSPPolicy) unsafeFor(directive string) bool {
+	for _, u := range p.Unsafe {
+		if strings.HasPrefix(u, directive+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+func (p CSPPolicy) String() string {
+	o := make([]string, len(p.Directives))
+	for i, d := range p.Directives {
+		o[i] = strings.Join(append([]string{d.Name}, d.Values...), " ")
+	}
+	return strings.Join(o, "; ")
+}
+
+func (c CSP) String() string {
+	if !c.Present() && len(c.ReportOnly) == 0 {
+		return "none, no policy"
+	}
+	return fmt.Sprintf("%s, %d enforced, %d report-only", c.Strength, len(c.Policies), len(c.ReportOnly))
+}
+
+// reportCSP reports the policies of w.CSP and their unsafe sources.
+func reportCSP(w *Response) {
+	w.report("CSP: %s", w.CSP)
+	for _, set := range []struct {
+		kind     string
+		policies []CSPPolicy
+	}{{"enforced", w.CSP.Policies}, {"report-only", w.CSP.ReportOnly}} {
+		for _, p := range set.policies {
+			w.report("  %s (%s): %s", set.kind, p.Strength, p)
+			if len(p.Unsafe) > 0 {
+				w.report("    unsafe: %s", strings.Join(p.Unsafe, ", "))
+			}
+		}
+	}
+}
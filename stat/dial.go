@@ -0,0 +1,87 @@
+package stat
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// familyDialer dials over a fixed network, whatever the transport asks
+// for, to pin connections to one IP version.
+type familyDialer struct {
+	*net.Dialer
+	network string
+
+	// dnsTimeout bounds resolving the host, zero leaves it to the
+	// deadline of the dial
+	dnsTimeout time.Duration
+}
+
+// DialContext takes a standard library context, as http.Transport does.
+// It connects to the address pinned in ctx when there is one.
+func (d familyDialer) DialContext(ctx context.Context, _, addr string) (net.Conn, error) {
+	addr = pinAddr(ctx, addr)
+
+	host, port, err := net.SplitHostPort(addr)
+	if d.dnsTimeout == 0 || err != nil || net.ParseIP(host) != nil {
+		return d.Dialer.DialContext(ctx, d.network, addr)
+	}
+
+	ips, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	// addresses in the order the resolver returned them, as the
+	// dialer would without a fallback delay
+	for _, ip := range ips {
+		var conn net.Conn
+		conn, err = d.Dialer.DialContext(ctx, d.network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// lookup resolves host, within dnsTimeout when set. The lookup reports
+// to the client trace of ctx like the dialer's own.
+func (d familyDialer) lookup(ctx context.Context, host string) ([]net.IP, error) {
+	lctx := ctx
+	if d.dnsTimeout > 0 {
+		var cancel context.CancelFunc
+		lctx, cancel = context.WithTimeout(ctx, d.dnsTimeout)
+		defer cancel()
+	}
+
+	network := "ip"
+	switch d.network {
+	case "tcp4", "udp4":
+		network = "ip4"
+	case "tcp6", "udp6":
+		network = "ip6"
+	}
+
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	ips, err := resolver.LookupIP(lctx, network, host)
+	if err != nil && d.dnsTimeout > 0 && lctx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		return nil, &dnsTimeoutError{host, d.dnsTimeout}
+	}
+	return ips, err
+}
+
+// dnsTimeoutError is returned when resolving exceeds Request.DNSTimeout.
+type dnsTimeoutError struct {
+	host    string
+	timeout time.Duration
+}
+
+func (e *dnsTimeoutError) Error() string {
+	return fmt.Sprintf("DNS lookup of %s timed out after %s", e.host, e.timeout)
+}
+
+func (e *dnsTimeoutError) Timeout() bool { return true }
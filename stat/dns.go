@@ -0,0 +1,236 @@
+package stat
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// DNS record types queried directly.
+const (
+	dnsTypeA     = 1
+	dnsTypeCNAME = 5
+	dnsTypeAAAA  = 28
+)
+
+// dnsRecord is a resource record from the answer section of a reply.
+type dnsRecord struct {
+	Name string
+	Type uint16
+	TTL  uint32
+	Data string // address or target name
+}
+
+// defaultDNSServer returns the first nameserver of /etc/resolv.conf.
+func defaultDNSServer() string {
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return "127.0.0.1:53"
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], "53")
+		}
+	}
+	return "127.0.0.1:53"
+}
+
+// queryDNS sends a single recursive query for name to server over UDP
+// and returns the records of the answer section, in order.
+func queryDNS(ctx context.Context, server, name string, qtype uint16) ([]dnsRecord, error) {
+	if server == "" {
+		server = defaultDNSServer()
+	}
+
+	id := uint16(rand.Intn(1 << 16))
+	query, err := packQuery(id, name, qtype)
+	if err != nil {
+		return nil, err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 4096)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		if n >= 2 && binary.BigEndian.Uint16(buf) == id {
+			return unpackAnswers(buf[:n])
+		}
+		// a stray reply to someone else's query, keep waiting
+	}
+}
+
+func packQuery(id uint16, name string, qtype uint16) ([]byte, error) {
+	msg := make([]byte, 12, 512)
+	binary.BigEndian.PutUint16(msg[0:], id)
+	binary.BigEndian.PutUint16(msg[2:], 1<<8) // recursion desired
+	binary.BigEndian.PutUint16(msg[4:], 1)    // one question
+
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("invalid domain name %q", name)
+		}
+		msg = append(msg, byte(len(label)))
+		msg = append(msg, label...)
+	}
+	msg = append(msg, 0, byte(qtype>>8), byte(qtype), 0, 1) // class IN
+	return msg, nil
+}
+
+var errDNSFormat = errors.New("malformed DNS reply")
+
+func unpackAnswers(msg []byte) ([]dnsRecord, error) {
+	if len(msg) < 12 {
+		return nil, errDNSFormat
+	}
+
+	flags := binary.BigEndian.Uint16(msg[2:])
+	switch rcode := flags & 0xf; rcode {
+	case 0:
+	case 3:
+		return nil, errors.New("no such host")
+	default:
+		return nil, fmt.Errorf("DNS server returned error code %d", rcode)
+	}
+
+	qdcount := int(binary.BigEndian.Uint16(msg[4:]))
+	ancount := int(binary.BigEndian.Uint16(msg[6:]))
+
+	off := 12
+	for i := 0; i < qdcount; i++ {
+		_, n, err := unpackName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		off = n + 4 // type and class
+	}
+
+	var records []dnsRecord
+	for i := 0; i < ancount; i++ {
+		name, n, err := unpackName(msg, off)
+		if err != nil {
+			return nil, err
+		}
+		off = n
+		if off+10 > len(msg) {
+			return nil, errDNSFormat
+		}
+
+		rr := dnsRecord{
+			Name: name,
+			Type: binary.BigEndian.Uint16(msg[off:]),
+			TTL:  binary.BigEndian.Uint32(msg[off+4:]),
+		}
+		rdlen := int(binary.BigEndian.Uint16(msg[off+8:]))
+		off += 10
+		if off+rdlen > len(msg) {
+			return nil, errDNSFormat
+		}
+		rdata := msg[off : off+rdlen]
+
+		switch rr.Type {
+		case dnsTypeA, dnsTypeAAAA:
+			rr.Data = net.IP(rdata).String()
+		case dnsTypeCNAME:
+			if rr.Data, _, err = unpackName(msg, off); err != nil {
+				return nil, err
+			}
+		}
+		off += rdlen
+
+		records = append(records, rr)
+	}
+	return records, nil
+}
+
+// unpackName reads the possibly compressed name at off, returning it
+// along with the offset right after it.
+func unpackName(msg []byte, off int) (string, int, error) {
+	var labels []string
+	end := -1
+
+	for jumps := 0; ; {
+		if off >= len(msg) {
+			return "", 0, errDNSFormat
+		}
+
+		n := int(msg[off])
+		switch {
+		case n == 0:
+			if end == -1 {
+				end = off + 1
+			}
+			return strings.Join(labels, ".") + ".", end, nil
+
+		case n&0xc0 == 0xc0:
+			if off+1 >= len(msg) || jumps > 10 {
+				return "", 0, errDNSFormat
+			}
+			if end == -1 {
+				end = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(msg[off:]) & 0x3fff)
+			jumps++
+
+		default:
+			if off+1+n > len(msg) {
+				return "", 0, errDNSFormat
+			}
+			labels = append(labels, string(msg[off+1:off+1+n]))
+			off += 1 + n
+		}
+	}
+}
+
+// cnameChain follows the CNAME records of answers starting at host and
+// returns the names it passes through, host excluded.
+func cnameChain(host string, answers []dnsRecord) []string {
+	targets := make(map[string]string)
+	for _, rr := range answers {
+		if rr.Type == dnsTypeCNAME {
+			targets[strings.ToLower(rr.Name)] = rr.Data
+		}
+	}
+
+	var chain []string
+	name := strings.ToLower(strings.TrimSuffix(host, ".") + ".")
+	for len(chain) < len(targets) {
+		next, ok := targets[name]
+		if !ok {
+			break
+		}
+		chain = append(chain, strings.TrimSuffix(next, "."))
+		name = strings.ToLower(next)
+	}
+	return chain
+}
@@ -0,0 +1,127 @@
+package stat
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+
+	"golang.org/x/net/context"
+)
+
+// ErrorKind tells what part of a trace failed.
+type ErrorKind string
+
+// Kinds of TraceError.
+const (
+	// ErrorInvalid is a Request refused before anything was sent, for
+	// invalid options or options the Policy disallows.
+	ErrorInvalid ErrorKind = "invalid"
+
+	ErrorDNS     ErrorKind = "dns"     // resolving the host, DNSTimeout included
+	ErrorConnect ErrorKind = "connect" // connecting, refused or unreachable
+	ErrorTLS     ErrorKind = "tls"     // the handshake or the certificate
+
+	// ErrorTimeout is the Timeout or Deadline of the Request, or the
+	// deadline of its context, exceeded, or a network timeout.
+	ErrorTimeout ErrorKind = "timeout"
+
+	// ErrorCanceled is the context of the trace canceled.
+	ErrorCanceled ErrorKind = "canceled"
+
+	// ErrorFailed is anything else: the exchange with the server, or
+	// a check failing the trace such as MaxRedirects or ExpiryFailDays.
+	ErrorFailed ErrorKind = "failed"
+)
+
+// TraceError is the error of a trace that failed.
+type TraceError struct {
+	Kind ErrorKind
+	Msg  string
+
+	// Err is the cause, when there is one.
+	Err error
+}
+
+func (e *TraceError) Error() string { return e.Msg }
+
+func (e *TraceError) Unwrap() error { return e.Err }
+
+// invalidf returns the error of a Request refused before anything was
+// sent.
+func invalidf(format string, argv ...interface{}) error {
+	return &TraceError{Kind: ErrorInvalid, Msg: fmt.Sprintf(format, argv...)}
+}
+
+// failedf returns the error of a trace failed by a check, such as
+// MaxRedirects, rather than by the exchange with the server.
+func failedf(format string, argv ...interface{}) error {
+	return &TraceError{Kind: ErrorFailed, Msg: fmt.Sprintf(format, argv...)}
+}
+
+// failf returns the error of a trace failed on err, a failure to
+// exchange with the server, classified by errorKind.
+func failf(err error, format string, argv ...interface{}) error {
+	return &TraceError{Kind: errorKind(err), Msg: fmt.Sprintf(format, argv...), Err: err}
+}
+
+// errorKind classifies err, a failure to exchange with the server.
+func errorKind(err error) ErrorKind {
+	var dnsErr *net.DNSError
+	var dnsTimeout *dnsTimeoutError
+	var opErr *net.OpError
+	var ne net.Error
+	switch {
+	case errors.As(err, &dnsErr), errors.As(err, &dnsTimeout):
+		return ErrorDNS
+	case isTLSError(err):
+		return ErrorTLS
+	case errors.As(err, &ne) && ne.Timeout():
+		return ErrorTimeout
+	case errors.As(err, &opErr) && opErr.Op == "dial",
+		errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return ErrorConnect
+	}
+	return ErrorFailed
+}
+
+func isTLSError(err error) bool {
+	var (
+		alert      tls.AlertError
+		record     tls.RecordHeaderError
+		verify     *tls.CertificateVerificationError
+		authority  x509.UnknownAuthorityError
+		hostname   x509.HostnameError
+		invalid    x509.CertificateInvalidError
+		constraint x509.ConstraintViolationError
+	)
+	return errors.As(err, &alert) || errors.As(err, &record) || errors.As(err, &verify) ||
+		errors.As(err, &authority) || errors.As(err, &hostname) || errors.As(err, &invalid) ||
+		errors.As(err, &constraint) ||
+		// crypto/tls reports most handshake failures as plain errors
+		strings.Contains(err.Error(), "tls: ")
+}
+
+// traceError returns err, what a trace failed with, as a *TraceError.
+// Failures past the deadline or after cancellation of ctx are put down
+// to them; bound describes the deadline of the Request, if any.
+func traceError(err error, ctx context.Context, bound string) *TraceError {
+	te, ok := err.(*TraceError)
+	if !ok {
+		te = &TraceError{Kind: ErrorFailed, Msg: err.Error(), Err: err}
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		if bound != "" {
+			te = &TraceError{Kind: ErrorTimeout, Msg: fmt.Sprintf("Request timed out, %s exceeded", bound), Err: te}
+		}
+		te.Kind = ErrorTimeout
+	case context.Canceled:
+		te.Kind = ErrorCanceled
+	}
+	return te
+}
@@ -0,0 +1,291 @@
+package stat
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+)
+
+// heAttemptDelay is the head start IPv6 gets over IPv4, the Connection
+// Attempt Delay of RFC 8305.
+const heAttemptDelay = 250 * time.Millisecond
+
+// HappyEyeballs is the outcome of racing IPv6 and IPv4 connections to a
+// dual-stack host.
+type HappyEyeballs struct {
+	// Winner is the family of the connection used, "IPv6" or "IPv4",
+	// empty when neither connected.
+	Winner string `json:"winner"`
+
+	// attempts of each family, nil when the host has no address of it
+	IPv6 *FamilyAttempt `json:"ipv6,omitempty"`
+	IPv4 *FamilyAttempt `json:"ipv4,omitempty"`
+}
+
+// FamilyAttempt is the connection attempt of one address family. The
+// losing family is still connected, and the connection closed, to tell
+// how long it takes.
+type FamilyAttempt struct {
+	Addr    string        `json:"addr"`
+	Connect time.Duration `json:"connect"`
+	Err     string        `json:"error,omitempty"`
+
+	// Pending is set when the attempt was still going on when the
+	// trace ended.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Margin returns how much faster the winner connected than the loser,
+// negative when it only won thanks to its head start. It is zero unless
+// both families connected.
+func (h HappyEyeballs) Margin() time.Duration {
+	win, lose := h.IPv6, h.IPv4
+	if h.Winner == "IPv4" {
+		win, lose = lose, win
+	}
+	if win == nil || lose == nil || win.Err != "" || lose.Err != "" || lose.Pending {
+		return 0
+	}
+	return lose.Connect - win.Connect
+}
+
+func (h HappyEyeballs) String() string {
+	var o []string
+	for _, f := range []struct {
+		name string
+		a    *FamilyAttempt
+	}{{"IPv6", h.IPv6}, {"IPv4", h.IPv4}} {
+		switch {
+		case f.a == nil:
+			o = append(o, f.name+" no address")
+		case f.a.Pending:
+			o = append(o, fmt.Sprintf("%s still connecting to %s", f.name, f.a.Addr))
+		case f.a.Err != "":
+			o = append(o, fmt.Sprintf("%s failed: %s", f.name, f.a.Err))
+		default:
+			o = append(o, fmt.Sprintf("%s %s to %s", f.name, fmtms(f.a.Connect), f.a.Addr))
+		}
+	}
+	details := " (" + strings.Join(o, ", ") + ")"
+
+	// below a millisecond it is a tie
+	margin := h.Margin().Truncate(time.Millisecond)
+	switch {
+	case h.Winner == "":
+		return "no family connected" + details
+	case margin < 0:
+		return fmt.Sprintf("%s won on its head start, %s slower", h.Winner, fmtms(-margin)) + details
+	case margin > 0:
+		return fmt.Sprintf("%s won by %s", h.Winner, fmtms(margin)) + details
+	default:
+		return h.Winner + " won" + details
+	}
+}
+
+// eyeballsRace collects the race of the trace it is attached to; the
+// losing attempt may finish after the trace moved on.
+type eyeballsRace struct {
+	mu   sync.Mutex
+	race *HappyEyeballs
+}
+
+type eyeballsKey struct{}
+
+// withEyeballs makes eyeballsDialer record its race in race.
+func withEyeballs(ctx context.Context, race *eyeballsRace) context.Context {
+	return context.WithValue(ctx, eyeballsKey{}, race)
+}
+
+// snapshot returns the race so far, nil when no connection was dialed.
+func (e *eyeballsRace) snapshot() *HappyEyeballs {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.race == nil {
+		return nil
+	}
+
+	h := *e.race
+	if h.IPv6 != nil {
+		a := *h.IPv6
+		h.IPv6 = &a
+	}
+	if h.IPv4 != nil {
+		a := *h.IPv4
+		h.IPv4 = &a
+	}
+	return &h
+}
+
+// eyeballsDialer races connections over IPv6 and IPv4 as RFC 8305 has
+// it: IPv6 first, then IPv4 after heAttemptDelay or as soon as IPv6
+// failed, addresses of a family one after the other.
+type eyeballsDialer struct {
+	*net.Dialer
+}
+
+type familyResult struct {
+	attempt *FamilyAttempt
+	conn    net.Conn
+	err     error
+}
+
+// DialContext takes a standard library context, as http.Transport does.
+// It reports the race to the client trace of ctx as a single connection.
+func (d eyeballsDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	addr = pinAddr(ctx, addr)
+	race, _ := ctx.Value(eyeballsKey{}).(*eyeballsRace)
+
+	host, port, err := net.SplitHostPort(addr)
+	if race == nil || err != nil || net.ParseIP(host) != nil {
+		return d.Dialer.DialContext(ctx, network, addr)
+	}
+
+	// the lookup fires the DNS hooks of the client trace of ctx itself
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	ips, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var v6, v4 []string
+	for _, ip := range ips {
+		a := net.JoinHostPort(ip.String(), port)
+		if ip.IP.To4() != nil {
+			v4 = append(v4, a)
+		} else {
+			v6 = append(v6, a)
+		}
+	}
+
+	h := &HappyEyeballs{}
+	race.mu.Lock()
+	race.race = h
+	race.mu.Unlock()
+
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.ConnectStart != nil {
+		trace.ConnectStart("tcp", addr)
+	}
+	conn, winner, err := d.race(ctx, race, h, v6, v4)
+	if trace != nil && trace.ConnectDone != nil {
+		trace.ConnectDone("tcp", winner, err)
+	}
+	return conn, err
+}
+
+// race dials v6 and v4 addresses and returns the first connection made,
+// along with its address.
+func (d eyeballsDialer) race(ctx context.Context, race *eyeballsRace, h *HappyEyeballs, v6, v4 []string) (net.Conn, string, error) {
+	// dials outlive ctx once a winner is found, for the loser to
+	// finish; the trace hooks are fired for the race as a whole
+	dialCtx, cancel := context.WithCancel(untraced{context.WithoutCancel(ctx)})
+	stop := context.AfterFunc(ctx, cancel)
+
+	results := make(chan familyResult, 2)
+	start := func(addrs []string, a **FamilyAttempt) {
+		race.mu.Lock()
+		*a = &FamilyAttempt{Addr: addrs[0], Pending: true}
+		attempt := *a
+		race.mu.Unlock()
+		go func() {
+			conn, err := d.dialFamily(dialCtx, race, attempt, addrs)
+			results <- familyResult{attempt, conn, err}
+		}()
+	}
+
+	started := 0
+	if len(v6) > 0 {
+		start(v6, &h.IPv6)
+		started++
+	}
+	startV4 := func() {
+		if len(v4) > 0 && h.IPv4 == nil {
+			start(v4, &h.IPv4)
+			started++
+		}
+	}
+	delay := time.NewTimer(heAttemptDelay)
+	defer delay.Stop()
+	if started == 0 {
+		startV4()
+	}
+
+	var win familyResult
+	var lastErr error
+	received := 0
+	for win.conn == nil && received < started {
+		select {
+		case <-delay.C:
+			startV4()
+		case res := <-results:
+			received++
+			if res.err == nil {
+				win = res
+			} else {
+				lastErr = res.err
+				startV4()
+			}
+		}
+	}
+
+	if win.conn == nil {
+		stop()
+		cancel()
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no address to connect to")
+		}
+		return nil, "", lastErr
+	}
+
+	race.mu.Lock()
+	h.Winner = "IPv4"
+	if win.attempt == h.IPv6 {
+		h.Winner = "IPv6"
+	}
+	race.mu.Unlock()
+
+	// the loser is still dialed, to time it
+	startV4()
+	stop()
+	go func() {
+		for ; received < started; received++ {
+			if res := <-results; res.conn != nil {
+				res.conn.Close()
+			}
+		}
+		cancel()
+	}()
+	return win.conn, win.attempt.Addr, nil
+}
+
+// dialFamily connects to the first of addrs that accepts, recording the
+// attempt in a.
+func (d eyeballsDialer) dialFamily(ctx context.Context, race *eyeballsRace, a *FamilyAttempt, addrs []string) (net.Conn, error) {
+	start := time.Now()
+
+	var conn net.Conn
+	var err error
+	var addr string
+	for _, addr = range addrs {
+		if conn, err = d.Dialer.DialContext(ctx, "tcp", addr); err == nil {
+			break
+		}
+	}
+
+	race.mu.Lock()
+	defer race.mu.Unlock()
+	a.Addr = addr
+	a.Connect = time.Since(start)
+	a.Pending = false
+	if err != nil {
+		a.Err = err.Error()
+	}
+	return conn, err
+}
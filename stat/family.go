@@ -0,0 +1,38 @@
+package stat
+
+import "golang.org/x/net/context"
+
+// IP versions for Request.IPVersion.
+const (
+	IPv4 = 4
+	IPv6 = 6
+)
+
+// network returns the network to dial for the IP version of r.
+func (r *Request) network() string {
+	switch r.IPVersion {
+	case IPv4:
+		return "tcp4"
+	case IPv6:
+		return "tcp6"
+	default:
+		return "tcp"
+	}
+}
+
+// CompareIPVersions traces r over IPv4, then over IPv6, each on a fresh
+// connection, and puts both side by side.
+func CompareIPVersions(ctx context.Context, r *Request) *Comparison {
+	c := &Comparison{Labels: [2]string{"IPv4", "IPv6"}}
+
+	for i, v := range []int{IPv4, IPv6} {
+		t := NewTracer()
+
+		req := *r
+		req.IPVersion = v
+		c.Responses[i], c.Errs[i] = t.Trace(ctx, &req)
+
+		t.CloseIdleConnections()
+	}
+	return c
+}
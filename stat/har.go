@@ -0,0 +1,185 @@
+package stat
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// harRequest is the request of a HAR 1.2 entry, with the fields a
+// replay can use.
+type harRequest struct {
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	HTTPVersion string `json:"httpVersion"`
+	Headers     []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"headers"`
+	Cookies []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"cookies"`
+	PostData *struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+		Params   []struct {
+			Name        string `json:"name"`
+			Value       string `json:"value"`
+			FileName    string `json:"fileName"`
+			ContentType string `json:"contentType"`
+		} `json:"params"`
+	} `json:"postData"`
+}
+
+// RequestFromHAR builds a Request replaying entry (counted from 0) of
+// the HAR log in data, as exported by browsers. Method, URL, headers
+// and body are taken over; what cannot be replayed is dropped with a
+// warning.
+func RequestFromHAR(data []byte, entry int) (*Request, []string, error) {
+	var har struct {
+		Log *struct {
+			Entries []struct {
+				Request *harRequest `json:"request"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, nil, fmt.Errorf("invalid HAR: %v", err)
+	}
+	if har.Log == nil {
+		return nil, nil, fmt.Errorf("invalid HAR: no log")
+	}
+	if entry < 0 || entry >= len(har.Log.Entries) {
+		return nil, nil, fmt.Errorf("HAR has %d entries, no entry %d", len(har.Log.Entries), entry)
+	}
+	hr := har.Log.Entries[entry].Request
+	if hr == nil {
+		return nil, nil, fmt.Errorf("invalid HAR: entry %d has no request", entry)
+	}
+
+	r, err := replayRequest(hr.Method, hr.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	warn := func(format string, argv ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, argv...))
+	}
+
+	if v := hr.HTTPVersion; v != "" && !strings.HasPrefix(strings.ToUpper(v), "HTTP/1") {
+		warn("captured over %s, replayed over whatever the server negotiates", v)
+	}
+
+	hasCookie := false
+	for _, h := range hr.Headers {
+		switch {
+		case strings.HasPrefix(h.Name, ":"):
+			// HTTP/2 pseudo-headers, rebuilt from method and URL
+			continue
+		case skipReplayHeader(h.Name):
+			warn("dropped header %s, set by the transport", h.Name)
+			continue
+		case strings.EqualFold(h.Name, "Cookie"):
+			hasCookie = true
+		}
+		r.HTTPHeaders = append(r.HTTPHeaders, h.Name+": "+h.Value)
+	}
+	if !hasCookie && len(hr.Cookies) > 0 {
+		var pairs []string
+		for _, c := range hr.Cookies {
+			pairs = append(pairs, c.Name+"="+c.Value)
+		}
+		r.Cookies = strings.Join(pairs, "; ")
+	}
+
+	if pd := hr.PostData; pd != nil {
+		switch {
+		case pd.Text != "":
+			r.PostBody = pd.Text
+		case len(pd.Params) > 0 && strings.HasPrefix(pd.MimeType, "application/x-www-form-urlencoded"):
+			form := url.Values{}
+			for _, p := range pd.Params {
+				form.Add(p.Name, p.Value)
+			}
+			r.PostBody = form.Encode()
+		case len(pd.Params) > 0:
+			warn("dropped %s body, only its text or url-encoded parameters can be replayed", pd.MimeType)
+		}
+	}
+	return r, warnings, nil
+}
+
+// RequestFromRaw builds a Request replaying the HTTP/1.x request in
+// data, as captured on the wire or written by hand. The scheme, "http"
+// or "https", is not part of the request and has to be given.
+func RequestFromRaw(data []byte, scheme string) (*Request, []string, error) {
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid request: %v", err)
+	}
+	if req.Host == "" {
+		return nil, nil, fmt.Errorf("invalid request: no Host header")
+	}
+
+	u := *req.URL
+	u.Scheme, u.Host = scheme, req.Host
+	r, err := replayRequest(req.Method, u.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	for k, vv := range req.Header {
+		if skipReplayHeader(k) {
+			warnings = append(warnings, fmt.Sprintf("dropped header %s, set by the transport", k))
+			continue
+		}
+		for _, v := range vv {
+			r.HTTPHeaders = append(r.HTTPHeaders, k+": "+v)
+		}
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid request body: %v", err)
+	}
+	r.PostBody = string(body)
+	return r, warnings, nil
+}
+
+func replayRequest(method, rawurl string) (*Request, error) {
+	if method == "" {
+		return nil, fmt.Errorf("invalid request: no method")
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid request URL %q, need an absolute http or https URL", rawurl)
+	}
+
+	r, err := NewRequest(u.String())
+	if err != nil {
+		return nil, err
+	}
+	r.HTTPMethod = method
+	return r, nil
+}
+
+// skipReplayHeader reports whether header h is left to the transport
+// rather than replayed.
+func skipReplayHeader(h string) bool {
+	switch http.CanonicalHeaderKey(h) {
+	case "Host", "Content-Length", "Connection", "Keep-Alive", "Transfer-Encoding", "Upgrade", "Te":
+		return true
+	}
+	return false
+}
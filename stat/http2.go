@@ -0,0 +1,494 @@
+package stat
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/net/http2"
+)
+
+// HTTP2Settings overrides the SETTINGS the client advertises when it
+// negotiates HTTP/2. A zero value leaves the transport defaults alone.
+type HTTP2Settings struct {
+	MaxFrameSize      uint32 // SETTINGS_MAX_FRAME_SIZE
+	InitialWindowSize uint32 // SETTINGS_INITIAL_WINDOW_SIZE, 4 MiB at most
+	MaxHeaderListSize uint32 // SETTINGS_MAX_HEADER_LIST_SIZE
+}
+
+func (s HTTP2Settings) isZero() bool {
+	return s == HTTP2Settings{}
+}
+
+// settings returns the non-zero overrides as http2 settings.
+func (s HTTP2Settings) settings() []http2.Setting {
+	var o []http2.Setting
+	if s.MaxFrameSize != 0 {
+		o = append(o, http2.Setting{ID: http2.SettingMaxFrameSize, Val: s.MaxFrameSize})
+	}
+	if s.InitialWindowSize != 0 {
+		o = append(o, http2.Setting{ID: http2.SettingInitialWindowSize, Val: s.InitialWindowSize})
+	}
+	if s.MaxHeaderListSize != 0 {
+		o = append(o, http2.Setting{ID: http2.SettingMaxHeaderListSize, Val: s.MaxHeaderListSize})
+	}
+	return o
+}
+
+// maxInitialWindowSize is the stream flow control window of the http2
+// client, which does not grow with the window advertised: a server
+// sending more than it would overflow it.
+const maxInitialWindowSize = 4 << 20
+
+// validate checks the overrides against the limits of RFC 7540, section
+// 6.5.2, and InitialWindowSize against what the client accepts.
+func (s HTTP2Settings) validate() error {
+	for _, v := range s.settings() {
+		if err := v.Valid(); err != nil {
+			return invalidf("Invalid HTTP/2 setting %v=%d", v.ID, v.Val)
+		}
+	}
+	if s.InitialWindowSize > maxInitialWindowSize {
+		return invalidf("HTTP/2 initial window size %d exceeds %d, the window of the client", s.InitialWindowSize, maxInitialWindowSize)
+	}
+	return nil
+}
+
+func (s HTTP2Settings) String() string {
+	var o []string
+	for _, v := range s.settings() {
+		o = append(o, fmt.Sprintf("%v=%d", v.ID, v.Val))
+	}
+	return strings.Join(o, " ")
+}
+
+// configureHTTP2 opts tr into HTTP/2, advertising s to the server, and
+// keeping track of the streams of each connection when streams is set.
+func configureHTTP2(tr *http.Transport, s HTTP2Settings, streams bool) error {
+	if s.isZero() && !streams {
+		return http2.ConfigureTransport(tr)
+	}
+
+	// http2.ConfigureTransport does not expose the settings it sends,
+	// nor the connections, so register our own upgrade that rewrites
+	// the initial SETTINGS frame on its way out and watches frames go
+	// by.
+	t2 := &http2.Transport{
+		TLSClientConfig:   tr.TLSClientConfig,
+		MaxHeaderListSize: s.MaxHeaderListSize,
+	}
+
+	tr.TLSClientConfig.NextProtos = []string{"h2", "http/1.1"}
+	tr.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{
+		"h2": func(authority string, c *tls.Conn) http.RoundTripper {
+			fc := newFrameConn(c)
+			var conn tlsConn = fc
+			if !s.isZero() {
+				conn = &settingsConn{tlsConn: fc, settings: s.settings()}
+			}
+			cc, err := t2.NewClientConn(conn)
+			if err != nil {
+				go c.Close()
+				return errRoundTripper{err}
+			}
+			return &h2RoundTripper{cc: cc, conn: c, frames: fc}
+		},
+	}
+	return nil
+}
+
+// tlsConn is a connection telling its TLS state, which the http2
+// package hands over to responses.
+type tlsConn interface {
+	net.Conn
+	ConnectionState() tls.ConnectionState
+}
+
+// h2RoundTripper sends requests over a single HTTP/2 connection. It
+// reports GotConn itself, as the http2 package only does so when it
+// manages its own connection pool.
+type h2RoundTripper struct {
+	cc     *http2.ClientConn
+	conn   net.Conn
+	frames *frameConn
+	used   int32 // accessed atomically
+}
+
+func (rt *h2RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !rt.cc.CanTakeNewRequest() {
+		// let net/http dial another connection; it drops this one from
+		// its pool without closing it, so close it once it is done
+		rt.frames.closeWhenIdle()
+		return nil, errConnFull{}
+	}
+
+	reused := atomic.SwapInt32(&rt.used, 1) == 1
+	if trace := httptrace.ContextClientTrace(req.Context()); trace != nil && trace.GotConn != nil {
+		trace.GotConn(httptrace.GotConnInfo{Conn: rt.conn, Reused: reused})
+	}
+
+	if info, ok := req.Context().Value(http2InfoKey{}).(*HTTP2Info); ok {
+		// the http2 package opens the stream and writes its headers
+		// under the lock of the connection, so the stream last opened
+		// is that of req by the time they are written
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+			WroteHeaders: func() { *info = rt.frames.info() },
+		}))
+	}
+	return rt.cc.RoundTrip(req)
+}
+
+// errConnFull is returned for a connection at its stream limit. net/http
+// retries the request on another connection on errors with this method.
+type errConnFull struct{}
+
+func (errConnFull) Error() string             { return "http2: connection at its stream limit" }
+func (errConnFull) IsHTTP2NoCachedConnError() {}
+
+// settingsConn replaces values of the client's initial SETTINGS frame
+// with its own before the connection preface hits the wire.
+type settingsConn struct {
+	tlsConn
+
+	settings []http2.Setting
+	written  bool
+	buf      []byte // the preface and SETTINGS frame written so far
+}
+
+func (c *settingsConn) Write(p []byte) (int, error) {
+	if c.written {
+		return c.tlsConn.Write(p)
+	}
+
+	// the preface and the SETTINGS frame may come in several writes,
+	// hold them back until the frame is whole
+	c.buf = append(c.buf, p...)
+	n, ok := c.settingsEnd()
+	if !ok {
+		return len(p), nil
+	}
+	c.written = true
+	b := c.buf
+	c.buf = nil
+
+	out, err := c.rewrite(b[:n])
+	if err != nil {
+		return 0, err
+	}
+	// whatever followed the SETTINGS frame (a WINDOW_UPDATE) goes out as is
+	if _, err := c.tlsConn.Write(append(out, b[n:]...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// settingsEnd returns where the frame following the preface ends in
+// c.buf, and false while it is not all there. It returns 0 for writes
+// not starting with the preface, which go out as is.
+func (c *settingsConn) settingsEnd() (int, bool) {
+	preface := []byte(http2.ClientPreface)
+	if !bytes.HasPrefix(c.buf, preface) {
+		return 0, !bytes.HasPrefix(preface, c.buf)
+	}
+	fh, err := http2.ReadFrameHeader(bytes.NewReader(c.buf[len(preface):]))
+	if err != nil {
+		return 0, false
+	}
+	n := len(preface) + frameHeaderLen + int(fh.Length)
+	return n, len(c.buf) >= n
+}
+
+// rewrite returns the preface and SETTINGS frame in b with c.settings
+// merged into the frame.
+func (c *settingsConn) rewrite(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return b, nil
+	}
+	out := bytes.NewBufferString(http2.ClientPreface)
+	fr := http2.NewFramer(out, bytes.NewReader(b[len(http2.ClientPreface):]))
+	f, err := fr.ReadFrame()
+	if err != nil {
+		return nil, err
+	}
+	sf, ok := f.(*http2.SettingsFrame)
+	if !ok {
+		return b, nil
+	}
+
+	merged := map[http2.SettingID]uint32{}
+	var order []http2.SettingID
+	add := func(s http2.Setting) error {
+		if _, ok := merged[s.ID]; !ok {
+			order = append(order, s.ID)
+		}
+		merged[s.ID] = s.Val
+		return nil
+	}
+	sf.ForeachSetting(add)
+	for _, s := range c.settings {
+		add(s)
+	}
+
+	settings := make([]http2.Setting, 0, len(order))
+	for _, id := range order {
+		settings = append(settings, http2.Setting{ID: id, Val: merged[id]})
+	}
+	if err := fr.WriteSettings(settings...); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// errRoundTripper fails every request with err.
+type errRoundTripper struct{ err error }
+
+func (rt errRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, rt.err
+}
+
+// HTTP2Info tells which HTTP/2 stream a request used, and how busy its
+// connection was.
+type HTTP2Info struct {
+	StreamID uint32 `json:"stream_id"`
+
+	// ActiveStreams is how many streams were open on the connection
+	// when the request was sent, its own included, and PeakStreams the
+	// most ever open at once on it up to then.
+	ActiveStreams int `json:"active_streams"`
+	PeakStreams   int `json:"peak_streams"`
+
+	// MaxConcurrentStreams is the limit the server set on open
+	// streams, zero when it set none or its SETTINGS had not arrived
+	// yet, as for the first request of a connection.
+	MaxConcurrentStreams uint32 `json:"max_concurrent_streams"`
+}
+
+func (h HTTP2Info) String() string {
+	limit := "no limit known"
+	if h.MaxConcurrentStreams > 0 {
+		limit = fmt.Sprintf("limit %d", h.MaxConcurrentStreams)
+	}
+	return fmt.Sprintf("stream %d, %d streams active on the connection (peak %d, %s)",
+		h.StreamID, h.ActiveStreams, h.PeakStreams, limit)
+}
+
+type http2InfoKey struct{}
+
+// withHTTP2Info makes the HTTP/2 round trip of ctx record its stream in
+// info. It stays zero over HTTP/1.x.
+func withHTTP2Info(ctx context.Context, info *HTTP2Info) context.Context {
+	return context.WithValue(ctx, http2InfoKey{}, info)
+}
+
+// frameConn follows the HTTP/2 frames read and written over a
+// connection to tell which streams are open.
+type frameConn struct {
+	*tls.Conn
+
+	mu        sync.Mutex
+	out, in   frameScanner
+	open      map[uint32]uint8 // stream to the sides done with it
+	last      uint32           // stream last opened
+	peak      int
+	maxStream uint32
+	draining  bool // closing once the streams open are done
+}
+
+// sides of a stream done sending, once both are it is closed
+const (
+	clientDone = 1 << iota
+	serverDone
+)
+
+func newFrameConn(c *tls.Conn) *frameConn {
+	fc := &frameConn{Conn: c, open: make(map[uint32]uint8)}
+	fc.out = frameScanner{skip: len(http2.ClientPreface), frame: fc.sent}
+	fc.in = frameScanner{frame: fc.received}
+	return fc
+}
+
+func (c *frameConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	c.mu.Lock()
+	c.out.scan(p[:n])
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *frameConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.mu.Lock()
+	c.in.scan(p[:n])
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *frameConn) info() HTTP2Info {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return HTTP2Info{
+		StreamID:             c.last,
+		ActiveStreams:        len(c.open),
+		PeakStreams:          c.peak,
+		MaxConcurrentStreams: c.maxStream,
+	}
+}
+
+func (c *frameConn) sent(f frameHeader, _ []byte) {
+	switch f.typ {
+	case http2.FrameHeaders:
+		if f.stream > c.last {
+			c.last = f.stream
+			c.open[f.stream] = 0
+			if len(c.open) > c.peak {
+				c.peak = len(c.open)
+			}
+		}
+		fallthrough
+	case http2.FrameData:
+		if f.flags.Has(http2.FlagDataEndStream) {
+			c.done(f.stream, clientDone)
+		}
+	case http2.FrameRSTStream:
+		c.closed(f.stream)
+	}
+}
+
+func (c *frameConn) received(f frameHeader, payload []byte) {
+	switch f.typ {
+	case http2.FrameHeaders, http2.FrameData:
+		if f.flags.Has(http2.FlagDataEndStream) {
+			c.done(f.stream, serverDone)
+		}
+	case http2.FrameRSTStream:
+		c.closed(f.stream)
+	case http2.FrameSettings:
+		if f.flags.Has(http2.FlagSettingsAck) {
+			return
+		}
+		for ; len(payload) >= 6; payload = payload[6:] {
+			if http2.SettingID(binary.BigEndian.Uint16(payload)) == http2.SettingMaxConcurrentStreams {
+				c.maxStream = binary.BigEndian.Uint32(payload[2:])
+			}
+		}
+	}
+}
+
+func (c *frameConn) done(stream uint32, side uint8) {
+	sides, ok := c.open[stream]
+	if !ok {
+		return
+	}
+	if sides |= side; sides == clientDone|serverDone {
+		c.closed(stream)
+		return
+	}
+	c.open[stream] = sides
+}
+
+func (c *frameConn) closed(stream uint32) {
+	delete(c.open, stream)
+	if c.draining && len(c.open) == 0 {
+		go c.Conn.Close()
+	}
+}
+
+// closeWhenIdle closes the connection once no stream is open on it
+// anymore, right away when none is.
+func (c *frameConn) closeWhenIdle() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.draining {
+		return
+	}
+	c.draining = true
+	if len(c.open) == 0 {
+		go c.Conn.Close()
+	}
+}
+
+type frameHeader struct {
+	length uint32
+	typ    http2.FrameType
+	flags  http2.Flags
+	stream uint32
+}
+
+// frameHeaderLen is the length of the header of every HTTP/2 frame.
+const frameHeaderLen = 9
+
+// frameScanner splits a stream of bytes into HTTP/2 frames, handing
+// over the header of each, and the payload of SETTINGS frames, the only
+// ones looked into.
+type frameScanner struct {
+	skip  int // bytes before the first frame, the client preface
+	frame func(f frameHeader, payload []byte)
+
+	hdr     [frameHeaderLen]byte
+	n       int    // bytes of hdr filled
+	left    uint32 // bytes of the payload not scanned yet
+	payload []byte
+}
+
+func (s *frameScanner) scan(p []byte) {
+	for len(p) > 0 {
+		if s.skip > 0 {
+			k := len(p)
+			if k > s.skip {
+				k = s.skip
+			}
+			s.skip -= k
+			p = p[k:]
+			continue
+		}
+
+		if s.n < len(s.hdr) {
+			k := copy(s.hdr[s.n:], p)
+			s.n += k
+			p = p[k:]
+			if s.n < len(s.hdr) {
+				return
+			}
+			if s.left = s.header().length; s.left == 0 {
+				s.emit()
+			}
+			continue
+		}
+
+		k := uint32(len(p))
+		if k > s.left {
+			k = s.left
+		}
+		if s.header().typ == http2.FrameSettings {
+			s.payload = append(s.payload, p[:k]...)
+		}
+		s.left -= k
+		p = p[k:]
+		if s.left == 0 {
+			s.emit()
+		}
+	}
+}
+
+func (s *frameScanner) emit() {
+	s.frame(s.header(), s.payload)
+	s.n = 0
+	s.payload = nil
+}
+
+func (s *frameScanner) header() frameHeader {
+	return frameHeader{
+		length: uint32(s.hdr[0])<<16 | uint32(s.hdr[1])<<8 | uint32(s.hdr[2]),
+		typ:    http2.FrameType(s.hdr[3]),
+		flags:  http2.Flags(s.hdr[4]),
+		stream: binary.BigEndian.Uint32(s.hdr[5:]) & (1<<31 - 1),
+	}
+}
@@ -0,0 +1,209 @@
+package stat
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quic-go/quic-go"
+)
+
+// Protocols of Request.ForceHTTP.
+const (
+	ForceHTTP1 = "1.1"
+	ForceHTTP2 = "2"
+	ForceHTTP3 = "3"
+)
+
+// validateForceHTTP checks the protocol forced is one that can be.
+// HTTP/3 runs over QUIC, so the options of TCP connections do not apply
+// to it.
+func validateForceHTTP(r *Request) error {
+	switch r.ForceHTTP {
+	case "", ForceHTTP1:
+	case ForceHTTP2:
+		if r.URL.Scheme != "https" {
+			return invalidf("HTTP/2 can only be forced over https, cleartext HTTP/2 is not supported")
+		}
+	case ForceHTTP3:
+		if r.URL.Scheme != "https" {
+			return invalidf("HTTP/3 can only be forced over https")
+		}
+		switch {
+		case r.SOCKS5 != "":
+			return invalidf("HTTP/3 cannot be forced through a SOCKS5 proxy, QUIC runs over UDP")
+		case r.HappyEyeballs, r.TCPFastOpen:
+			return invalidf("HappyEyeballs and TCPFastOpen do not apply to HTTP/3, which runs over UDP")
+		}
+	default:
+		return invalidf("Invalid ForceHTTP %q, expected %q, %q or %q", r.ForceHTTP, ForceHTTP1, ForceHTTP2, ForceHTTP3)
+	}
+	if r.ForceHTTP != "" && r.Conn != nil {
+		return invalidf("ForceHTTP does not apply to a provided connection")
+	}
+	return nil
+}
+
+// quicDialer dials the QUIC connections of HTTP/3, each over a UDP
+// socket of its own. It reports to the client trace of ctx as a TCP
+// dial would, the QUIC handshake, which sets up the connection and
+// TLS at once, standing in for the TLS handshake.
+type quicDialer struct {
+	familyDialer // resolves, over a "udp" network
+	denyPrivate  bool
+}
+
+// Dial has the signature of http3.Transport.Dial. It connects to the
+// address pinned in ctx when there is one.
+func (d quicDialer) Dial(ctx context.Context, addr string, tlsConf *tls.Config, conf *quic.Config) (*quic.Conn, error) {
+	// the socket comes first, so that only the handshake follows the
+	// lookup
+	udp, err := net.ListenUDP(d.network, nil)
+	if err != nil {
+		return nil, err
+	}
+	qt := &quic.Transport{Conn: udp}
+	closeAll := func() {
+		qt.Close()
+		udp.Close()
+	}
+
+	raddr, err := d.resolve(ctx, pinAddr(ctx, addr))
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	if d.denyPrivate {
+		if err := controlDial(d.network, raddr.String(), nil); err != nil {
+			closeAll()
+			return nil, &net.OpError{Op: "dial", Net: d.network, Addr: raddr, Err: err}
+		}
+	}
+
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil {
+		// UDP has nothing to connect, the handshake does it all
+		if trace.ConnectStart != nil {
+			trace.ConnectStart(d.network, raddr.String())
+		}
+		if trace.ConnectDone != nil {
+			trace.ConnectDone(d.network, raddr.String(), nil)
+		}
+		if trace.TLSHandshakeStart != nil {
+			trace.TLSHandshakeStart()
+		}
+	}
+	conn, err := qt.Dial(ctx, raddr, tlsConf, conf)
+	if trace != nil && trace.TLSHandshakeDone != nil {
+		var state tls.ConnectionState
+		if conn != nil {
+			state = conn.ConnectionState().TLS
+		}
+		trace.TLSHandshakeDone(state, err)
+	}
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+
+	go func() {
+		<-conn.Context().Done()
+		closeAll()
+	}()
+	return conn, nil
+}
+
+// resolve returns the UDP address of addr, the first the resolver
+// returns for a host name.
+func (d quicDialer) resolve(ctx context.Context, addr string) (*net.UDPAddr, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	p, err := net.LookupPort(d.network, port)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return &net.UDPAddr{IP: ip, Port: p}, nil
+	}
+
+	ips, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	return &net.UDPAddr{IP: ips[0], Port: p}, nil
+}
+
+// AltService is an alternative service a response advertises in its
+// Alt-Svc header, see RFC 7838.
+type AltService struct {
+	// Protocol is an ALPN protocol ID, h3 for HTTP/3.
+	Protocol string `json:"protocol"`
+
+	// Authority is "host:port", the host being empty for the same host.
+	Authority string `json:"authority"`
+
+	// MaxAge is how long the advertisement may be cached.
+	MaxAge time.Duration `json:"max_age"`
+}
+
+func (a AltService) String() string {
+	return fmt.Sprintf("%s at %s for %s", a.Protocol, a.Authority, a.MaxAge)
+}
+
+// HTTP3 reports whether a is HTTP/3, final or one of its drafts.
+func (a AltService) HTTP3() bool {
+	return a.Protocol == "h3" || strings.HasPrefix(a.Protocol, "h3-")
+}
+
+// parseAltSvc parses the values of Alt-Svc headers. The "clear" value,
+// which withdraws earlier advertisements, yields nothing, as do
+// malformed entries.
+func parseAltSvc(values []string) []AltService {
+	var services []AltService
+	for _, v := range values {
+		for _, entry := range strings.Split(v, ",") {
+			params := strings.Split(entry, ";")
+			i := strings.IndexByte(params[0], '=')
+			if i == -1 {
+				continue
+			}
+			a := AltService{
+				Protocol:  strings.TrimSpace(params[0][:i]),
+				Authority: strings.Trim(strings.TrimSpace(params[0][i+1:]), `"`),
+				MaxAge:    24 * time.Hour,
+			}
+			for _, p := range params[1:] {
+				k, v, ok := strings.Cut(strings.TrimSpace(p), "=")
+				if !ok || strings.ToLower(k) != "ma" {
+					continue
+				}
+				if s, err := strconv.Atoi(strings.Trim(v, `"`)); err == nil && s >= 0 {
+					a.MaxAge = time.Duration(s) * time.Second
+				}
+			}
+			if a.Protocol != "" && a.Authority != "" {
+				services = append(services, a)
+			}
+		}
+	}
+	return services
+}
+
+// reportAltSvc reports the alternative services the final response,
+// received over HTTP/major, advertises, HTTP/3 among them.
+func reportAltSvc(w *Response, major int) {
+	for _, a := range w.AltSvc {
+		if a.HTTP3() && major != 3 {
+			w.report("Alt-Svc: %s, HTTP/3 offered but not traced, see ForceHTTP3", a)
+		} else {
+			w.report("Alt-Svc: %s", a)
+		}
+	}
+}
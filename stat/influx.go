@@ -0,0 +1,123 @@
+package stat
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// InfluxSink writes the timings of traces to an InfluxDB write endpoint
+// using the line protocol, one "urlstat" point per trace.
+//
+// Points are buffered and written in batches by a goroutine of the
+// sink's own, Record never blocks: when the buffer is full, because
+// InfluxDB is slow or down, points are dropped.
+type InfluxSink struct {
+	// URL of the write endpoint, e.g. http://localhost:8086/write?db=urlstat
+	URL string
+
+	points chan string
+	done   chan struct{}
+	client *http.Client
+}
+
+const (
+	influxBatchSize     = 100
+	influxFlushInterval = 10 * time.Second
+)
+
+// NewInfluxSink starts a sink writing to url. Close it to flush the
+// points still buffered.
+func NewInfluxSink(url string) *InfluxSink {
+	s := &InfluxSink{
+		URL:    url,
+		points: make(chan string, 10*influxBatchSize),
+		done:   make(chan struct{}),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	go s.run()
+	return s
+}
+
+// Record queues the timings of resp, a trace of r.
+func (s *InfluxSink) Record(r *Request, resp *Response) {
+	select {
+	case s.points <- influxLine(r, resp, time.Now()):
+	default:
+		log.Printf("influx: buffer full, dropping point for %s", r.URL)
+	}
+}
+
+// Close flushes the buffered points and stops the sink.
+func (s *InfluxSink) Close() {
+	close(s.points)
+	<-s.done
+}
+
+func (s *InfluxSink) run() {
+	defer close(s.done)
+
+	tick := time.NewTicker(influxFlushInterval)
+	defer tick.Stop()
+
+	var batch []string
+	for {
+		select {
+		case p, ok := <-s.points:
+			if !ok {
+				s.write(batch)
+				return
+			}
+			batch = append(batch, p)
+			if len(batch) < influxBatchSize {
+				continue
+			}
+		case <-tick.C:
+		}
+
+		s.write(batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *InfluxSink) write(batch []string) {
+	if len(batch) == 0 {
+		return
+	}
+
+	body := bytes.NewBufferString(strings.Join(batch, "\n"))
+	resp, err := s.client.Post(s.URL, "text/plain; charset=utf-8", body)
+	if err != nil {
+		log.Printf("influx: failed to write %d points: %v", len(batch), err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		log.Printf("influx: failed to write %d points: %s", len(batch), resp.Status)
+	}
+}
+
+// influxLine formats resp as a line protocol point taken at ts.
+func influxLine(r *Request, resp *Response, ts time.Time) string {
+	ms := func(d time.Duration) string {
+		return fmt.Sprintf("%g", float64(d)/float64(time.Millisecond))
+	}
+
+	t := resp.Timings
+	return fmt.Sprintf("urlstat,host=%s,status=%d dns=%s,tcp=%s,tls=%s,server=%s,transfer=%s,total=%s %d",
+		influxEscape(r.URL.Host), resp.StatusCode,
+		ms(t.DNSLookup), ms(t.TCPConnection), ms(t.TLSHandshake),
+		ms(t.ServerProcessing), ms(t.ContentTransfer), ms(t.Total),
+		ts.UnixNano())
+}
+
+var influxEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// influxEscape escapes a tag value.
+func influxEscape(v string) string {
+	return influxEscaper.Replace(v)
+}
@@ -0,0 +1,350 @@
+package stat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONPathResult is the value Request.BodyJSONPath found in the body.
+type JSONPathResult struct {
+	Path string `json:"path"`
+
+	// Value is the JSON of the value found, an array of the values
+	// found when the path has wildcards or recursive descent.
+	Value json.RawMessage `json:"value,omitempty"`
+
+	// Err tells why no value was found.
+	Err string `json:"error,omitempty"`
+
+	v interface{} // Value decoded, for assertions
+}
+
+func (r JSONPathResult) String() string {
+	if r.Err != "" {
+		return fmt.Sprintf("%s: %s", r.Path, r.Err)
+	}
+	return fmt.Sprintf("%s = %s", r.Path, r.Value)
+}
+
+// jsonPath is a compiled JSONPath expression. The subset supported is
+//
+//	$           the root
+//	.name       a member, also ['name'] or ["name"]
+//	[n]         an array element, counted from the end when negative
+//	.* or [*]   all members or elements
+//	..name      the members called name at any depth, also ..*
+//
+// Filters, slices and unions are not supported.
+type jsonPath []pathStep
+
+type pathStep struct {
+	op    byte // '.' member, '[' element, '*' wildcard, 'd' descendants
+	name  string
+	index int
+}
+
+// definite reports whether p selects a single value at most.
+func (p jsonPath) definite() bool {
+	for _, s := range p {
+		if s.op == '*' || s.op == 'd' {
+			return false
+		}
+	}
+	return true
+}
+
+func compileJSONPath(expr string) (jsonPath, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("JSONPath must start with $")
+	}
+
+	var p jsonPath
+	i := 1
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("JSONPath column %d: %s", i+1, fmt.Sprintf(format, args...))
+	}
+	name := func() string {
+		j := i
+		for j < len(expr) && (isNameByte(expr[j]) || expr[j] >= 0x80) {
+			j++
+		}
+		n := expr[i:j]
+		i = j
+		return n
+	}
+
+	for i < len(expr) {
+		switch {
+		case strings.HasPrefix(expr[i:], ".."):
+			i += 2
+			if strings.HasPrefix(expr[i:], "*") {
+				i++
+				p = append(p, pathStep{op: 'd'})
+				continue
+			}
+			n := name()
+			if n == "" {
+				return nil, errorf("expected a name after ..")
+			}
+			p = append(p, pathStep{op: 'd', name: n})
+
+		case expr[i] == '.':
+			i++
+			if strings.HasPrefix(expr[i:], "*") {
+				i++
+				p = append(p, pathStep{op: '*'})
+				continue
+			}
+			n := name()
+			if n == "" {
+				return nil, errorf("expected a name after .")
+			}
+			p = append(p, pathStep{op: '.', name: n})
+
+		case expr[i] == '[':
+			i++
+			end := strings.IndexByte(expr[i:], ']')
+			if end == -1 {
+				return nil, errorf("missing ]")
+			}
+			sel := strings.TrimSpace(expr[i : i+end])
+			switch {
+			case sel == "*":
+				p = append(p, pathStep{op: '*'})
+			case len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0]:
+				p = append(p, pathStep{op: '.', name: sel[1 : len(sel)-1]})
+			default:
+				n, err := strconv.Atoi(sel)
+				if err != nil {
+					return nil, errorf("unsupported selector [%s]", sel)
+				}
+				p = append(p, pathStep{op: '[', index: n})
+			}
+			i += end + 1
+
+		default:
+			return nil, errorf("unexpected %q", expr[i])
+		}
+	}
+	return p, nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c == '-' || c == '$' ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// Bounds of evaluating a JSONPath: the values a step selects, the
+// values visited in all, and the size of the JSON of the values found.
+// Chained recursive descent would grow well beyond the size of the body
+// without them.
+const (
+	maxJSONPathMatches = 10000
+	maxJSONPathVisits  = 1000000
+	maxJSONPathValue   = 1 << 20
+)
+
+// eval returns the values p selects in doc, in document order, members
+// of objects sorted by name. It gives up once a step selects more than
+// maxJSONPathMatches values or maxJSONPathVisits were visited.
+func (p jsonPath) eval(doc interface{}) ([]interface{}, error) {
+	var errLimit error
+	visits := 0
+	visit := func() bool {
+		visits++
+		if visits > maxJSONPathVisits {
+			errLimit = fmt.Errorf("path visits more than %d values", maxJSONPathVisits)
+			return false
+		}
+		return true
+	}
+
+	nodes := []interface{}{doc}
+	for _, s := range p {
+		var next []interface{}
+		add := func(v interface{}) bool {
+			if len(next) == maxJSONPathMatches {
+				errLimit = fmt.Errorf("path selects more than %d values", maxJSONPathMatches)
+				return false
+			}
+			next = append(next, v)
+			return true
+		}
+
+		for _, n := range nodes {
+			ok := true
+			switch s.op {
+			case '.':
+				if m, isMap := n.(map[string]interface{}); isMap {
+					if v, found := m[s.name]; found {
+						ok = add(v)
+					}
+				}
+			case '[':
+				if a, isArray := n.([]interface{}); isArray {
+					i := s.index
+					if i < 0 {
+						i += len(a)
+					}
+					if i >= 0 && i < len(a) {
+						ok = add(a[i])
+					}
+				}
+			case '*':
+				for _, c := range children(n) {
+					if ok = add(c); !ok {
+						break
+					}
+				}
+			case 'd':
+				ok = descendants(n, s.name, visit, add)
+			}
+			if !ok {
+				return nil, errLimit
+			}
+		}
+		nodes = next
+	}
+	return nodes, nil
+}
+
+func children(n interface{}) []interface{} {
+	switch n := n.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		o := make([]interface{}, len(keys))
+		for i, k := range keys {
+			o[i] = n[k]
+		}
+		return o
+	case []interface{}:
+		return n
+	}
+	return nil
+}
+
+// descendants adds the members called name of n and of everything
+// below it, or everything below n when name is empty, visiting each
+// value below n. It stops as soon as visit or add returns false, and
+// returns false then.
+func descendants(n interface{}, name string, visit func() bool, add func(interface{}) bool) bool {
+	if m, ok := n.(map[string]interface{}); ok && name != "" {
+		if v, ok := m[name]; ok && !add(v) {
+			return false
+		}
+	}
+	for _, c := range children(n) {
+		if !visit() {
+			return false
+		}
+		if name == "" && !add(c) {
+			return false
+		}
+		if !descendants(c, name, visit, add) {
+			return false
+		}
+	}
+	return true
+}
+
+// extractJSONPath evaluates expr against body, which must hold the
+// whole of a JSON document.
+func extractJSONPath(expr string, body *Body) *JSONPathResult {
+	r := &JSONPathResult{Path: expr}
+	p, err := compileJSONPath(expr)
+	switch {
+	case err != nil:
+		r.Err = err.Error()
+		return r
+	case body == nil:
+		r.Err = "body was not read"
+		return r
+	case body.Truncated:
+		r.Err = fmt.Sprintf("body truncated at %d of %d bytes, raise MaxBodyBytes", len(body.Content), body.Size)
+		return r
+	case body.Encoding != "":
+		r.Err = "body is not JSON, it is not valid UTF-8"
+		return r
+	}
+
+	d := json.NewDecoder(strings.NewReader(body.Content))
+	d.UseNumber()
+	var doc interface{}
+	if err := d.Decode(&doc); err != nil {
+		r.Err = fmt.Sprintf("body is not JSON: %v", err)
+		return r
+	}
+	if _, err := d.Token(); err == nil {
+		r.Err = "body is not JSON: data after the top-level value"
+		return r
+	}
+
+	found, err := p.eval(doc)
+	switch {
+	case err != nil:
+		r.Err = err.Error()
+		return r
+	case len(found) == 0:
+		r.Err = "no match"
+		return r
+	}
+
+	// encoded value by value, to stop at the first going over the bound
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	if !p.definite() {
+		b.WriteByte('[')
+	}
+	for i, v := range found {
+		if i > 0 {
+			b.Truncate(b.Len() - 1) // the newline Encode ends with
+			b.WriteByte(',')
+		}
+		e.Encode(v)
+		if b.Len() > maxJSONPathValue {
+			r.Err = fmt.Sprintf("values found exceed %d bytes of JSON", maxJSONPathValue)
+			return r
+		}
+	}
+	b.Truncate(b.Len() - 1)
+	if !p.definite() {
+		b.WriteByte(']')
+	}
+
+	r.v = found
+	if p.definite() {
+		r.v = found[0]
+	}
+	r.Value = b.Bytes()
+	return r
+}
+
+// value returns what r found for assertions: a number, string or
+// boolean as such, anything else as its JSON.
+func (r *JSONPathResult) value() (assertValue, error) {
+	if r.Err != "" {
+		return assertValue{}, fmt.Errorf("body JSON path %s", r)
+	}
+	switch v := r.v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return assertValue{}, err
+		}
+		return assertNumber(f), nil
+	case string:
+		return assertString(v), nil
+	case bool:
+		return assertBool(v), nil
+	}
+	return assertString(string(r.Value)), nil
+}
@@ -0,0 +1,83 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeepAlive describes whether the server intends to keep the
+// connection open after a response.
+type KeepAlive struct {
+	Persistent bool `json:"persistent"`
+
+	// advertised by a Keep-Alive header, zero when absent
+	Timeout time.Duration `json:"timeout,omitempty"`
+	Max     int           `json:"max,omitempty"`
+}
+
+func newKeepAlive(resp *http.Response) *KeepAlive {
+	ka := &KeepAlive{Persistent: !resp.Close}
+
+	switch {
+	case resp.ProtoMajor >= 2:
+		// connections are always persistent
+		ka.Persistent = true
+	case resp.ProtoAtLeast(1, 1):
+		// persistent unless told otherwise, resp.Close covers that
+	default:
+		ka.Persistent = headerHasToken(resp.Header, "Connection", "keep-alive")
+	}
+
+	for _, param := range strings.Split(resp.Header.Get("Keep-Alive"), ",") {
+		i := strings.Index(param, "=")
+		if i == -1 {
+			continue
+		}
+		k, v := strings.TrimSpace(param[:i]), strings.TrimSpace(param[i+1:])
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		switch strings.ToLower(k) {
+		case "timeout":
+			ka.Timeout = time.Duration(n) * time.Second
+		case "max":
+			ka.Max = n
+		}
+	}
+	return ka
+}
+
+func (ka KeepAlive) String() string {
+	if !ka.Persistent {
+		return "no, connection will be closed"
+	}
+
+	var params []string
+	if ka.Timeout != 0 {
+		params = append(params, fmt.Sprintf("timeout=%s", ka.Timeout))
+	}
+	if ka.Max != 0 {
+		params = append(params, fmt.Sprintf("max=%d", ka.Max))
+	}
+	if len(params) == 0 {
+		return "yes"
+	}
+	return "yes (" + strings.Join(params, ", ") + ")"
+}
+
+// headerHasToken reports whether the comma separated header k contains
+// token, ignoring case.
+func headerHasToken(h http.Header, k, token string) bool {
+	for _, v := range h[http.CanonicalHeaderKey(k)] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
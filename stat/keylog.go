@@ -0,0 +1,47 @@
+package stat
+
+import (
+	"os"
+	"sync"
+)
+
+// keyLogFile returns the TLS key log file of r: KeyLogFile, or else the
+// file named by SSLKEYLOGFILE, as browsers do.
+func (r *Request) keyLogFile() string {
+	if r.KeyLogFile != "" {
+		return r.KeyLogFile
+	}
+	return os.Getenv("SSLKEYLOGFILE")
+}
+
+// keyLogWriter appends TLS secrets in NSS key log format to a file, for
+// Wireshark to decrypt captured traffic. Failing to write must not fail
+// the handshake; the error is kept for traces to report instead.
+type keyLogWriter struct {
+	name string
+
+	mu  sync.Mutex
+	err error
+}
+
+func (k *keyLogWriter) Write(p []byte) (int, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	f, err := os.OpenFile(k.name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err == nil {
+		_, err = f.Write(p)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	k.err = err
+	return len(p), nil
+}
+
+// lastErr returns the error of the last write, if it failed.
+func (k *keyLogWriter) lastErr() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.err
+}
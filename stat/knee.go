@@ -0,0 +1,148 @@
+package stat
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// Concurrency limits of Tracer.Ramp.
+const (
+	defaultRampConcurrency = 16
+	maxRampConcurrency     = 64
+)
+
+// kneeFactor is how much slower than at concurrency 1 the median total
+// time of a level has to be for it to be the knee.
+const kneeFactor = 1.5
+
+// Ramp holds traces of the same request at increasing concurrency.
+type Ramp struct {
+	Levels []RampLevel
+
+	// Knee is the first concurrency at which the median total time
+	// exceeds kneeFactor times that at concurrency 1, zero if none did.
+	Knee int
+
+	// BudgetExceeded is set when the ramp stopped on TotalBudget.
+	BudgetExceeded bool
+}
+
+// RampLevel is the outcome of one concurrency level.
+type RampLevel struct {
+	Concurrency int
+	Samples     *Samples
+}
+
+// ErrorRate returns the share of failed traces, from 0 to 1.
+func (l RampLevel) ErrorRate() float64 {
+	if l.Samples.Completed() == 0 {
+		return 0
+	}
+	return float64(len(l.Samples.Errs)) / float64(l.Samples.Completed())
+}
+
+// Ramp traces r with 1, 2, 4, ... traces in flight at once, up to
+// r.MaxConcurrency (16 when zero, at most 64), to see where the server
+// starts slowing down. Each of the concurrent traces of a level runs
+// r.Samples times, at least once. The whole ramp takes at most
+// r.TotalBudget.
+func (t *Tracer) Ramp(ctx context.Context, r *Request) *Ramp {
+	max := r.MaxConcurrency
+	if max <= 0 {
+		max = defaultRampConcurrency
+	}
+	if max > maxRampConcurrency {
+		max = maxRampConcurrency
+	}
+
+	if r.TotalBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.TotalBudget)
+		defer cancel()
+	}
+
+	ramp := &Ramp{}
+	for n := 1; n <= max; n *= 2 {
+		if ctx.Err() != nil {
+			ramp.BudgetExceeded = r.TotalBudget > 0
+			break
+		}
+
+		level := RampLevel{Concurrency: n, Samples: &Samples{}}
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				// within the ramp's budget, Sample drops the
+				// traces it cuts short
+				s := t.Sample(ctx, r)
+
+				mu.Lock()
+				defer mu.Unlock()
+				level.Samples.Requested += s.Requested
+				level.Samples.Responses = append(level.Samples.Responses, s.Responses...)
+				level.Samples.Errs = append(level.Samples.Errs, s.Errs...)
+			}()
+		}
+		wg.Wait()
+
+		if ctx.Err() != nil && r.TotalBudget > 0 {
+			// traces cut short by the budget were dropped, and a
+			// partial level says little
+			level.Samples.BudgetExceeded = true
+			ramp.BudgetExceeded = true
+		}
+		ramp.Levels = append(ramp.Levels, level)
+	}
+
+	ramp.findKnee()
+	return ramp
+}
+
+func (r *Ramp) findKnee() {
+	if len(r.Levels) == 0 || len(r.Levels[0].Samples.Responses) == 0 {
+		return
+	}
+	_, base, _ := r.Levels[0].Samples.Aggregate()
+
+	for _, l := range r.Levels[1:] {
+		if len(l.Samples.Responses) == 0 {
+			continue
+		}
+		_, median, _ := l.Samples.Aggregate()
+		if float64(median.Total) > kneeFactor*float64(base.Total) {
+			r.Knee = l.Concurrency
+			return
+		}
+	}
+}
+
+func (r *Ramp) String() string {
+	var o []string
+	for _, l := range r.Levels {
+		line := fmt.Sprintf("Concurrency %2d: %d traces, %.0f%% errors", l.Concurrency,
+			l.Samples.Completed(), 100*l.ErrorRate())
+		if len(l.Samples.Responses) > 0 {
+			_, median, max := l.Samples.Aggregate()
+			line += fmt.Sprintf(", total median %s max %s, server median %s",
+				fmtms(median.Total), fmtms(max.Total), fmtms(median.ServerProcessing))
+		}
+		o = append(o, line)
+	}
+
+	switch {
+	case r.Knee > 0:
+		o = append(o, fmt.Sprintf("Knee: slows down from concurrency %d", r.Knee))
+	case len(r.Levels) > 0:
+		o = append(o, "Knee: none found")
+	}
+	if r.BudgetExceeded {
+		o = append(o, "Stopped by the time budget")
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,163 @@
+package stat
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Content types of the two exposition formats of MetricsSink.
+const (
+	PrometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// phaseBuckets are the upper bounds of the histogram buckets, in
+// seconds.
+var phaseBuckets = [...]float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// maxExemplarLabels is how many characters the labels of an exemplar
+// may hold, per the OpenMetrics specification.
+const maxExemplarLabels = 128
+
+// A MetricsSink aggregates the phase timings of traces into histograms
+// for Prometheus to scrape. In the OpenMetrics format, each bucket
+// carries an exemplar: the latest trace that fell into it, with its
+// trace ID and target, to jump from a metric to the trace in a tracing
+// backend.
+//
+// A MetricsSink is safe for concurrent use.
+type MetricsSink struct {
+	mu     sync.Mutex
+	phases [6]histogram // in the order of metricPhases
+}
+
+// metricPhases names the phases of Timings in the phase label.
+var metricPhases = [...]string{"dns_lookup", "tcp_connection", "tls_handshake", "server_processing", "content_transfer", "total"}
+
+type histogram struct {
+	counts    [len(phaseBuckets) + 1]uint64 // not cumulative, +Inf last
+	exemplars [len(phaseBuckets) + 1]*exemplar
+	sum       float64
+	count     uint64
+}
+
+type exemplar struct {
+	traceID, target string
+	value           float64
+	at              time.Time
+}
+
+func NewMetricsSink() *MetricsSink {
+	return &MetricsSink{}
+}
+
+// Record adds the timings of resp, traced under traceID, to the
+// histograms.
+func (s *MetricsSink) Record(resp *Response, traceID string) {
+	t := resp.Timings
+	durations := [...]time.Duration{t.DNSLookup, t.TCPConnection, t.TLSHandshake, t.ServerProcessing, t.ContentTransfer, t.Total}
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, d := range durations {
+		h := &s.phases[i]
+		v := d.Seconds()
+
+		b := len(phaseBuckets)
+		for j, le := range phaseBuckets {
+			if v <= le {
+				b = j
+				break
+			}
+		}
+		h.counts[b]++
+		h.exemplars[b] = &exemplar{traceID, resp.FinalURL, v, now}
+		h.sum += v
+		h.count++
+	}
+}
+
+// Expose returns the histograms in the Prometheus text format, or in
+// the OpenMetrics one, with exemplars, when openMetrics is set.
+func (s *MetricsSink) Expose(openMetrics bool) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b bytes.Buffer
+	b.WriteString("# HELP urlstat_phase_seconds Time spent in each phase of traces.\n")
+	b.WriteString("# TYPE urlstat_phase_seconds histogram\n")
+	for i, phase := range metricPhases {
+		h := &s.phases[i]
+
+		var cumulative uint64
+		for j := range h.counts {
+			cumulative += h.counts[j]
+			le := "+Inf"
+			if j < len(phaseBuckets) {
+				le = fmt.Sprint(phaseBuckets[j])
+			}
+			fmt.Fprintf(&b, "urlstat_phase_seconds_bucket{phase=%q,le=%q} %d", phase, le, cumulative)
+			if e := h.exemplars[j]; openMetrics && e != nil {
+				fmt.Fprintf(&b, " # %s %g %.3f", e.labels(), e.value, float64(e.at.UnixNano())/1e9)
+			}
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "urlstat_phase_seconds_sum{phase=%q} %g\n", phase, h.sum)
+		fmt.Fprintf(&b, "urlstat_phase_seconds_count{phase=%q} %d\n", phase, h.count)
+	}
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+	return b.Bytes()
+}
+
+// labels returns the label set of e, the target cut short so that the
+// whole set fits in maxExemplarLabels characters.
+func (e *exemplar) labels() string {
+	room := maxExemplarLabels - len("trace_id") - len(e.traceID) - len("target")
+	target := []rune(e.target)
+	if len(target) > room {
+		target = target[:room]
+	}
+	return fmt.Sprintf(`{trace_id="%s",target="%s"}`, e.traceID, labelEscaper.Replace(string(target)))
+}
+
+// labelEscaper escapes label values for the exposition formats.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// AcceptsOpenMetrics reports whether the Accept header of a scrape asks
+// for the OpenMetrics format.
+func AcceptsOpenMetrics(accept string) bool {
+	for _, v := range strings.Split(accept, ",") {
+		mt, _, err := mime.ParseMediaType(v)
+		if err == nil && mt == "application/openmetrics-text" {
+			return true
+		}
+	}
+	return false
+}
+
+// TraceID returns the trace ID of a W3C traceparent header, so that
+// exemplars point to the trace the caller started. When traceparent is
+// missing or invalid, it returns a new random ID.
+func TraceID(traceparent string) string {
+	// version "-" trace-id "-" parent-id "-" flags
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) == 4 && len(parts[1]) == 32 && parts[1] != strings.Repeat("0", 32) {
+		if _, err := hex.DecodeString(parts[1]); err == nil {
+			return strings.ToLower(parts[1])
+		}
+	}
+
+	var id [16]byte
+	rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
@@ -0,0 +1,374 @@
+package stat
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Bounds of Monitor.Interval.
+const (
+	MinMonitorInterval = 10 * time.Second
+	MaxMonitorInterval = 24 * time.Hour
+)
+
+// ErrNoMonitor is returned for the ID of a monitor that does not exist.
+var ErrNoMonitor = errors.New("No such monitor")
+
+// Monitor is a URL traced at a regular interval. It holds plain data
+// only, for stores to keep it wherever they like.
+type Monitor struct {
+	ID       string        `json:"id"`
+	URL      string        `json:"url"`
+	Interval time.Duration `json:"interval"`
+
+	// Timeout bounds each trace, the default of NewRequest when zero.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	Created time.Time `json:"created"`
+}
+
+// MonitorCheck is the outcome of one trace of a monitor.
+type MonitorCheck struct {
+	Time       time.Time           `json:"time"`
+	StatusCode int                 `json:"status_code,omitempty"`
+	Timings    *MillisecondTimings `json:"timings,omitempty"`
+	Err        string              `json:"error,omitempty"`
+
+	// Up is set when the trace succeeded with a status below 400.
+	Up bool `json:"up"`
+}
+
+func (c MonitorCheck) String() string {
+	line := c.Time.UTC().Format(time.RFC3339)
+	if c.Err != "" {
+		return line + " down: " + c.Err
+	}
+	state := "up"
+	if !c.Up {
+		state = "down"
+	}
+	return fmt.Sprintf("%s %s %d in %.0fms", line, state, c.StatusCode, c.Timings.Total)
+}
+
+// MonitorStore keeps monitors and the recent checks of each. Stores are
+// safe for concurrent use, and return ErrNoMonitor for unknown IDs.
+type MonitorStore interface {
+	Add(m Monitor) error
+	Remove(id string) error
+	Get(id string) (Monitor, error)
+	List() ([]Monitor, error)
+
+	// Record adds c to the history of monitor id, which a store may
+	// bound by dropping the oldest checks.
+	Record(id string, c MonitorCheck) error
+
+	// History returns the last limit checks of monitor id, all of those
+	// kept when limit is zero, oldest first.
+	History(id string, limit int) ([]MonitorCheck, error)
+}
+
+// MemoryMonitorStore is a MonitorStore in memory, keeping up to a
+// number of checks per monitor. Everything is lost on restart.
+type MemoryMonitorStore struct {
+	history int
+
+	mu       sync.Mutex
+	monitors map[string]*memoryMonitor
+}
+
+type memoryMonitor struct {
+	Monitor
+	checks []MonitorCheck
+}
+
+// NewMemoryMonitorStore returns a store keeping the last history checks
+// of each monitor.
+func NewMemoryMonitorStore(history int) *MemoryMonitorStore {
+	return &MemoryMonitorStore{history: history, monitors: make(map[string]*memoryMonitor)}
+}
+
+func (s *MemoryMonitorStore) Add(m Monitor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.monitors[m.ID] = &memoryMonitor{Monitor: m}
+	return nil
+}
+
+func (s *MemoryMonitorStore) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.monitors[id]; !ok {
+		return ErrNoMonitor
+	}
+	delete(s.monitors, id)
+	return nil
+}
+
+func (s *MemoryMonitorStore) Get(id string) (Monitor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.monitors[id]
+	if !ok {
+		return Monitor{}, ErrNoMonitor
+	}
+	return m.Monitor, nil
+}
+
+func (s *MemoryMonitorStore) List() ([]Monitor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]Monitor, 0, len(s.monitors))
+	for _, m := range s.monitors {
+		list = append(list, m.Monitor)
+	}
+	return list, nil
+}
+
+func (s *MemoryMonitorStore) Record(id string, c MonitorCheck) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.monitors[id]
+	if !ok {
+		return ErrNoMonitor
+	}
+	m.checks = append(m.checks, c)
+	if len(m.checks) > s.history {
+		m.checks = append([]MonitorCheck(nil), m.checks[len(m.checks)-s.history:]...)
+	}
+	return nil
+}
+
+func (s *MemoryMonitorStore) History(id string, limit int) ([]MonitorCheck, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.monitors[id]
+	if !ok {
+		return nil, ErrNoMonitor
+	}
+	checks := m.checks
+	if limit > 0 && len(checks) > limit {
+		checks = checks[len(checks)-limit:]
+	}
+	return append([]MonitorCheck{}, checks...), nil
+}
+
+// Monitors traces the monitors of a store, each in a goroutine of its
+// own, from when it is added until it is removed or Monitors closed.
+// Every check traces over a new connection, as Trace does, so that its
+// timings include connecting.
+//
+// Monitors is safe for concurrent use.
+type Monitors struct {
+	store MonitorStore
+	max   int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+}
+
+// NewMonitors starts the monitors already in store, and up to max
+// monitors overall.
+func NewMonitors(store MonitorStore, max int) (*Monitors, error) {
+	list, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	ms := &Monitors{store: store, max: max, running: make(map[string]context.CancelFunc)}
+	ms.ctx, ms.cancel = context.WithCancel(context.Background())
+	for _, m := range list {
+		ms.start(m)
+	}
+	return ms, nil
+}
+
+// Add adds a monitor tracing rawurl every interval, each trace bounded
+// by timeout, or the default of NewRequest when zero, which must not
+// exceed interval. It starts it with a first trace right away. The URL
+// must pass DefaultPolicy.
+func (ms *Monitors) Add(rawurl string, interval, timeout time.Duration) (Monitor, error) {
+	r, err := NewRequest(rawurl)
+	if err != nil {
+		return Monitor{}, err
+	}
+	if timeout > 0 {
+		r.Timeout = timeout
+	}
+	if err := DefaultPolicy.check(r); err != nil {
+		return Monitor{}, err
+	}
+	if r.URL.Scheme != "http" && r.URL.Scheme != "https" {
+		return Monitor{}, invalidf("Monitor URL must be http or https, got %s", r.URL.Scheme)
+	}
+	if interval < MinMonitorInterval || interval > MaxMonitorInterval {
+		return Monitor{}, invalidf("Monitor interval must be between %s and %s", MinMonitorInterval, MaxMonitorInterval)
+	}
+	if r.Timeout > interval {
+		// checks would overlap
+		return Monitor{}, invalidf("Monitor timeout %s exceeds its interval %s", r.Timeout, interval)
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.ctx.Err() != nil {
+		return Monitor{}, errors.New("Monitors closed")
+	}
+	if len(ms.running) >= ms.max {
+		return Monitor{}, fmt.Errorf("Too many monitors, %d at most", ms.max)
+	}
+
+	m := Monitor{
+		ID:       randomID(),
+		URL:      r.URL.String(),
+		Interval: interval,
+		Timeout:  timeout,
+		Created:  time.Now(),
+	}
+	if err := ms.store.Add(m); err != nil {
+		return Monitor{}, err
+	}
+	ms.startLocked(m)
+	return m, nil
+}
+
+// Remove stops monitor id, aborting a trace in progress, and removes it
+// from the store along with its history.
+func (ms *Monitors) Remove(id string) error {
+	ms.mu.Lock()
+	if cancel, ok := ms.running[id]; ok {
+		cancel()
+		delete(ms.running, id)
+	}
+	ms.mu.Unlock()
+	return ms.store.Remove(id)
+}
+
+// List returns the monitors of the store.
+func (ms *Monitors) List() ([]Monitor, error) {
+	return ms.store.List()
+}
+
+// MonitorHistory is a monitor with its recent checks.
+type MonitorHistory struct {
+	Monitor Monitor        `json:"monitor"`
+	Checks  []MonitorCheck `json:"checks"`
+
+	// Uptime is the percentage of Checks that were up, zero without
+	// checks.
+	Uptime float64 `json:"uptime"`
+}
+
+// History returns monitor id with its last limit checks, all those the
+// store kept when limit is zero.
+func (ms *Monitors) History(id string, limit int) (*MonitorHistory, error) {
+	m, err := ms.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := ms.store.History(id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &MonitorHistory{Monitor: m, Checks: checks}
+	if len(checks) > 0 {
+		up := 0
+		for _, c := range checks {
+			if c.Up {
+				up++
+			}
+		}
+		h.Uptime = 100 * float64(up) / float64(len(checks))
+	}
+	return h, nil
+}
+
+func (h MonitorHistory) String() string {
+	o := []string{fmt.Sprintf("Monitor %s every %s: %.2f%% up over %d checks",
+		h.Monitor.URL, h.Monitor.Interval, h.Uptime, len(h.Checks))}
+	for _, c := range h.Checks {
+		o = append(o, "  "+c.String())
+	}
+	return strings.Join(o, "\n")
+}
+
+// Close stops all monitors, aborting the traces in progress, and waits
+// for them to return. The store is left as is.
+func (ms *Monitors) Close() {
+	ms.mu.Lock()
+	ms.cancel()
+	ms.running = make(map[string]context.CancelFunc)
+	ms.mu.Unlock()
+	ms.wg.Wait()
+}
+
+func (ms *Monitors) start(m Monitor) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.startLocked(m)
+}
+
+func (ms *Monitors) startLocked(m Monitor) {
+	ctx, cancel := context.WithCancel(ms.ctx)
+	ms.running[m.ID] = cancel
+	ms.wg.Add(1)
+	go ms.run(ctx, m)
+}
+
+// run checks m every m.Interval until ctx is done.
+func (ms *Monitors) run(ctx context.Context, m Monitor) {
+	defer ms.wg.Done()
+
+	tick := time.NewTicker(m.Interval)
+	defer tick.Stop()
+	for {
+		ms.check(ctx, m)
+		select {
+		case <-tick.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check traces m once and records the outcome, unless ctx was done,
+// m being removed, in the meantime.
+func (ms *Monitors) check(ctx context.Context, m Monitor) {
+	r, err := NewRequest(m.URL)
+	if err != nil {
+		// checked by Add
+		return
+	}
+	if m.Timeout > 0 {
+		r.Timeout = m.Timeout
+	}
+
+	c := MonitorCheck{Time: time.Now()}
+	t := NewTracer()
+	resp, err := t.Trace(ctx, r)
+	t.CloseIdleConnections()
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		c.Err = err.Error()
+	} else {
+		timings := resp.Timings.Milliseconds()
+		c.StatusCode = resp.StatusCode
+		c.Timings = &timings
+		c.Up = resp.StatusCode < 400
+	}
+	if err := ms.store.Record(m.ID, c); err != nil && err != ErrNoMonitor {
+		log.Printf("monitor %s: recording check failed: %v", m.ID, err)
+	}
+}
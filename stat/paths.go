@@ -0,0 +1,80 @@
+package stat
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// PathResult is the trace of one of the paths given to TracePaths.
+type PathResult struct {
+	Path     string
+	Response *Response
+	Err      error
+
+	// NewConnection is set when the path could not reuse the
+	// connection of the paths before it.
+	NewConnection bool
+}
+
+// PathsResult gathers the traces of several paths on one host.
+type PathsResult struct {
+	Paths []PathResult
+}
+
+// TracePaths traces every path, resolved against the URL of base, one
+// after the other with t, so that all of them share a single warm
+// connection: DNS, TCP and TLS are only measured once.
+func TracePaths(ctx context.Context, t *Tracer, base *Request, paths []string) *PathsResult {
+	result := &PathsResult{}
+
+	for i, p := range paths {
+		ref, err := url.Parse(p)
+		if err != nil {
+			result.Paths = append(result.Paths, PathResult{Path: p, Err: err})
+			continue
+		}
+
+		req := *base
+		req.URL = base.URL.ResolveReference(ref)
+
+		resp, err := t.Trace(ctx, &req)
+		result.Paths = append(result.Paths, PathResult{
+			Path:          p,
+			Response:      resp,
+			Err:           err,
+			NewConnection: err == nil && i > 0 && !resp.Reused,
+		})
+	}
+	return result
+}
+
+func (p PathsResult) String() string {
+	var o []string
+	connected := false
+
+	for _, v := range p.Paths {
+		if v.Err != nil {
+			o = append(o, fmt.Sprintf("%s: %v", v.Path, v.Err))
+			continue
+		}
+
+		t := v.Response.Timings
+		if !connected {
+			o = append(o, fmt.Sprintf("Connection: DNS lookup %s, TCP connection %s, TLS handshake %s",
+				fmtms(t.DNSLookup), fmtms(t.TCPConnection), fmtms(t.TLSHandshake)))
+			connected = true
+		}
+
+		line := fmt.Sprintf("%s: %d server=%s transfer=%s",
+			v.Path, v.Response.StatusCode, fmtms(t.ServerProcessing), fmtms(t.ContentTransfer))
+		if v.NewConnection {
+			line += fmt.Sprintf(" (new connection: DNS lookup %s, TCP connection %s, TLS handshake %s)",
+				fmtms(t.DNSLookup), fmtms(t.TCPConnection), fmtms(t.TLSHandshake))
+		}
+		o = append(o, line)
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,90 @@
+package stat
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+)
+
+// A Policy restricts the Request options a trace may use. It exists for
+// services tracing URLs on behalf of untrusted users.
+type Policy struct {
+	AllowInsecure       bool // Request.Insecure
+	AllowPrivateTargets bool // loopback, private and link-local addresses
+	AllowClientCertFile bool // Request.ClientCertFile
+	AllowKeyLogFile     bool // Request.KeyLogFile
+
+	// AllowedMethods lists the HTTP methods that may be used, nil
+	// allows any.
+	AllowedMethods []string
+}
+
+// FullPolicy allows every option.
+var FullPolicy = Policy{
+	AllowInsecure:       true,
+	AllowPrivateTargets: true,
+	AllowClientCertFile: true,
+	AllowKeyLogFile:     true,
+}
+
+// SafePolicy only allows tracing public addresses with the common
+// methods, verifying certificates and without reading local files.
+var SafePolicy = Policy{
+	AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+}
+
+// DefaultPolicy applies to Trace and to Tracers without a Policy of
+// their own. Library users get FullPolicy unless they change it.
+var DefaultPolicy = FullPolicy
+
+// check fails when r uses an option p does not allow.
+func (p *Policy) check(r *Request) error {
+	if r.Insecure && !p.AllowInsecure {
+		return invalidf("Skipping certificate verification is disabled by policy")
+	}
+	if r.ClientCertFile != "" && !p.AllowClientCertFile {
+		return invalidf("Reading client certificates from file is disabled by policy")
+	}
+	if r.KeyLogFile != "" && !p.AllowKeyLogFile {
+		return invalidf("Writing a TLS key log is disabled by policy")
+	}
+	if r.SOCKS5 != "" && !p.AllowPrivateTargets {
+		// the proxy resolves the target, it cannot be checked here
+		return invalidf("Tracing through a SOCKS5 proxy is disabled by policy")
+	}
+	if p.AllowedMethods != nil && !p.allowsMethod(r.HTTPMethod) {
+		return invalidf("Method %s is disabled by policy", r.HTTPMethod)
+	}
+	return nil
+}
+
+func (p *Policy) allowsMethod(method string) bool {
+	for _, m := range p.AllowedMethods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// controlDial refuses connections to private addresses. It runs once
+// the address is resolved, so a name cannot be rebound in between.
+func controlDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip != nil && isPrivate(ip) {
+		return fmt.Errorf("connecting to %s is disabled by policy", ip)
+	}
+	return nil
+}
+
+func isPrivate(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified()
+}
@@ -0,0 +1,275 @@
+package stat
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// States of a port probed by Tracer.ProbePorts.
+const (
+	PortOpen     = "open"
+	PortRefused  = "refused"
+	PortTimedOut = "timed out"
+	PortFailed   = "failed"
+)
+
+const (
+	maxProbePorts = 32
+
+	// how long a port has to accept a connection, or to complete a
+	// TLS handshake, a filtered port never answers
+	portTimeout = 5 * time.Second
+)
+
+// tlsPorts are the ports TLS is expected on, besides that of an https
+// URL.
+var tlsPorts = map[int]bool{443: true, 465: true, 636: true, 853: true, 993: true, 995: true, 8443: true}
+
+// PortsProbe holds the probes of several ports of a host.
+type PortsProbe struct {
+	Host string `json:"host"`
+
+	// the address probed, the first the host resolved to
+	Addr      string        `json:"addr"`
+	DNSLookup time.Duration `json:"dns_lookup"`
+
+	Ports []PortProbe `json:"ports"`
+}
+
+// PortProbe is the probe of one port.
+type PortProbe struct {
+	Port  int    `json:"port"`
+	State string `json:"state"`
+	Err   string `json:"error,omitempty"`
+
+	Connect time.Duration `json:"connect"`
+
+	// TLS is set when a handshake was attempted, on an open port
+	// expected to speak TLS.
+	TLS          bool          `json:"tls"`
+	TLSHandshake time.Duration `json:"tls_handshake,omitempty"`
+	TLSErr       string        `json:"tls_error,omitempty"`
+
+	// the trace of the URL on this port, when asked for
+	Response *Response `json:"-"`
+	HTTPErr  string    `json:"http_error,omitempty"`
+}
+
+// ProbePorts resolves the host of r once, then connects to each of
+// ports at once, giving each portTimeout to accept. On open ports
+// expected to speak TLS, those of tlsPorts and that of r.URL when it is
+// https, it times a handshake too. With traceHTTP, it then traces the
+// URL of r moved to each open port, over https where TLS succeeded. The
+// policy of t applies, and the Timeout or Deadline of r bounds it all.
+func (t *Tracer) ProbePorts(ctx context.Context, r *Request, ports []int, traceHTTP bool) (*PortsProbe, error) {
+	policy := t.policy()
+	if err := policy.check(r); err != nil {
+		return nil, err
+	}
+	if err := validateResolvers(r.Resolvers); err != nil {
+		return nil, err
+	}
+	if len(ports) == 0 || len(ports) > maxProbePorts {
+		return nil, invalidf("Between 1 and %d ports can be probed", maxProbePorts)
+	}
+	for _, port := range ports {
+		if port < 1 || port > 65535 {
+			return nil, invalidf("Invalid port %d", port)
+		}
+	}
+
+	ctx, cancel, bound := r.withDeadline(ctx)
+	defer cancel()
+
+	host := r.URL.Hostname()
+	p := &PortsProbe{Host: host}
+	start := time.Now()
+	addr, err := r.resolveHost(ctx, host)
+	if err != nil {
+		return nil, traceError(err, ctx, bound)
+	}
+	p.DNSLookup = time.Since(start)
+	p.Addr = addr
+
+	https := -1
+	if r.URL.Scheme == "https" {
+		https, _ = strconv.Atoi(portOf(r.URL))
+	}
+
+	p.Ports = make([]PortProbe, len(ports))
+	var wg sync.WaitGroup
+	for i, port := range ports {
+		wg.Add(1)
+		go func(pp *PortProbe, port int) {
+			defer wg.Done()
+			pp.Port = port
+			r.probePort(ctx, policy, pp, p.Addr, tlsPorts[port] || port == https)
+
+			if traceHTTP && pp.State == PortOpen {
+				req := *r
+				req.URL = portURL(r.URL, port)
+				req.URL.Scheme = "http"
+				if pp.TLS && pp.TLSErr == "" {
+					req.URL.Scheme = "https"
+				}
+				resp, err := t.Trace(ctx, &req)
+				if err != nil {
+					pp.HTTPErr = err.Error()
+				}
+				pp.Response = resp
+			}
+		}(&p.Ports[i], port)
+	}
+	wg.Wait()
+	return p, nil
+}
+
+// resolveHost returns the first address of host, from the fastest of
+// r.Resolvers to answer when set, else from the system resolver within
+// r.DNSTimeout, as a trace of r would.
+func (r *Request) resolveHost(ctx context.Context, host string) (string, error) {
+	if len(r.Resolvers) > 0 && net.ParseIP(host) == nil {
+		qtype := uint16(dnsTypeA)
+		if r.IPVersion == IPv6 {
+			qtype = dnsTypeAAAA
+		}
+		// the answers of the others are buffered, none is waited for
+		if fastest, _ := resolveParallel(ctx, r.Resolvers, host, qtype); fastest != nil {
+			return fastest.Addrs[0], nil
+		}
+	}
+
+	d := familyDialer{Dialer: &net.Dialer{}, network: r.network(), dnsTimeout: r.DNSTimeout}
+	ips, err := d.lookup(ctx, host)
+	if err != nil {
+		var dnsErr *dnsTimeoutError
+		if errors.As(err, &dnsErr) {
+			return "", failf(err, "DNS lookup timed out, DNSTimeout of %s exceeded", dnsErr.timeout)
+		}
+		return "", failf(err, "Unable to resolve %s: %v", host, err)
+	}
+	return ips[0].String(), nil
+}
+
+// PortsTraces returns how many traces ProbePorts runs at once at most
+// for r over ports ports, at least one for the probes.
+func (r *Request) PortsTraces(ports int, traceHTTP bool) int {
+	if !traceHTTP || ports < 1 {
+		return 1
+	}
+	return ports * r.Traces()
+}
+
+// probePort connects to port of addr and, when handshake is set,
+// handshakes over the connection.
+func (r *Request) probePort(ctx context.Context, policy *Policy, p *PortProbe, addr string, handshake bool) {
+	dialer := &net.Dialer{Timeout: portTimeout}
+	if !policy.AllowPrivateTargets {
+		dialer.Control = controlDial
+	}
+
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, r.network(), net.JoinHostPort(addr, strconv.Itoa(p.Port)))
+	p.Connect = time.Since(start)
+	if err != nil {
+		p.Err = err.Error()
+		var ne net.Error
+		switch {
+		case errors.Is(err, syscall.ECONNREFUSED):
+			p.State = PortRefused
+		case errors.As(err, &ne) && ne.Timeout():
+			p.State = PortTimedOut
+		default:
+			p.State = PortFailed
+		}
+		return
+	}
+	defer conn.Close()
+	p.State = PortOpen
+	if !handshake {
+		return
+	}
+
+	p.TLS = true
+	serverName := r.serverName()
+	if serverName == "" {
+		serverName = r.URL.Hostname()
+	}
+	conn.SetDeadline(time.Now().Add(portTimeout))
+	tc := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: r.Insecure,
+	})
+	start = time.Now()
+	err = tc.HandshakeContext(ctx)
+	p.TLSHandshake = time.Since(start)
+	if err != nil {
+		p.TLSErr = err.Error()
+	}
+}
+
+func (p PortProbe) String() string {
+	line := fmt.Sprintf("%5d %s", p.Port, p.State)
+	if p.State != PortOpen {
+		return line + fmt.Sprintf(" after %s: %s", fmtms(p.Connect), p.Err)
+	}
+	line += fmt.Sprintf(", connect %s", fmtms(p.Connect))
+	switch {
+	case p.TLSErr != "":
+		line += fmt.Sprintf(", TLS handshake failed after %s: %s", fmtms(p.TLSHandshake), p.TLSErr)
+	case p.TLS:
+		line += fmt.Sprintf(", TLS handshake %s", fmtms(p.TLSHandshake))
+	}
+	switch {
+	case p.HTTPErr != "":
+		line += ", HTTP failed: " + p.HTTPErr
+	case p.Response != nil:
+		line += fmt.Sprintf(", HTTP %d in %s", p.Response.StatusCode, fmtms(p.Response.Timings.Total))
+	}
+	return line
+}
+
+func (p PortsProbe) String() string {
+	open := 0
+	for _, pp := range p.Ports {
+		if pp.State == PortOpen {
+			open++
+		}
+	}
+	o := []string{fmt.Sprintf("Ports of %s (%s, DNS lookup %s): %d of %d open",
+		p.Host, p.Addr, fmtms(p.DNSLookup), open, len(p.Ports))}
+	for _, pp := range p.Ports {
+		o = append(o, pp.String())
+	}
+	return strings.Join(o, "\n")
+}
+
+// ParsePorts parses a comma separated list of ports.
+func ParsePorts(s string) ([]int, error) {
+	var ports []int
+	for _, f := range strings.Split(s, ",") {
+		port, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", f)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// portURL is u on port.
+func portURL(u *url.URL, port int) *url.URL {
+	v := *u
+	v.Host = net.JoinHostPort(u.Hostname(), strconv.Itoa(port))
+	return &v
+}
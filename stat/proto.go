@@ -0,0 +1,315 @@
+package stat
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// protoSchemaVersion is the version of urlstat.proto written by
+// MarshalProto.
+const protoSchemaVersion = 1
+
+// ProtoContentType is the media type of Response.MarshalProto output.
+const ProtoContentType = "application/x-protobuf"
+
+// MarshalProto encodes r as the Response message of urlstat.proto, a
+// more compact form than JSON for exchanging results between vantage
+// points. Fields the schema does not describe are left out.
+func (r *Response) MarshalProto() []byte {
+	var e protoEncoder
+	e.uint(1, protoSchemaVersion)
+	for _, l := range r.Log {
+		e.bytes(2, []byte(l))
+	}
+	e.int(3, int64(r.StatusCode))
+	e.message(4, func(e *protoEncoder) {
+		t := r.Timings
+		e.int(1, int64(t.DNSLookup))
+		e.int(2, int64(t.TCPConnection))
+		e.int(3, int64(t.TLSHandshake))
+		e.int(4, int64(t.ServerProcessing))
+		e.int(5, int64(t.ContentTransfer))
+		e.int(6, int64(t.Total))
+	})
+	e.string(5, r.RequestLine)
+	e.bool(6, r.Reused)
+	for _, c := range r.CNAMEs {
+		e.bytes(7, []byte(c))
+	}
+	for _, rd := range r.Redirects {
+		rd := rd
+		e.message(8, func(e *protoEncoder) {
+			e.string(1, rd.From)
+			e.string(2, rd.To)
+			e.bool(3, rd.HostChanged)
+			e.bool(4, rd.PortChanged)
+		})
+	}
+	if info := r.TLS; info != nil {
+		e.message(9, func(e *protoEncoder) {
+			if !info.NotAfter.IsZero() {
+				e.int(1, info.NotAfter.Unix())
+			}
+			e.int(2, int64(info.DaysRemaining))
+			e.bool(3, info.ClientCertRequested)
+			e.bool(4, info.ClientCertSent)
+			e.bool(5, info.RenegotiationAttempted)
+			e.int(6, int64(info.Verification))
+		})
+	}
+	e.bool(10, r.RemoteDNS)
+	e.string(11, r.method)
+	e.string(12, r.url)
+	for _, a := range r.HeaderAnomalies {
+		a := a
+		e.message(13, func(e *protoEncoder) {
+			e.string(1, a.Code)
+			e.string(2, a.Description)
+		})
+	}
+	for _, w := range r.Warnings {
+		w := w
+		e.message(14, func(e *protoEncoder) {
+			e.string(1, w.Code)
+			e.string(2, w.Message)
+		})
+	}
+	return e.buf
+}
+
+// UnmarshalProto decodes a Response message of urlstat.proto, written
+// by this or any other version of MarshalProto.
+func UnmarshalProto(b []byte) (*Response, error) {
+	r := &Response{}
+	err := protoDecode(b, func(num int, v protoValue) error {
+		switch num {
+		case 2:
+			r.Log = append(r.Log, string(v.b))
+		case 3:
+			r.StatusCode = int(v.int())
+		case 4:
+			return protoDecode(v.b, func(num int, v protoValue) error {
+				d := time.Duration(v.int())
+				switch num {
+				case 1:
+					r.Timings.DNSLookup = d
+				case 2:
+					r.Timings.TCPConnection = d
+				case 3:
+					r.Timings.TLSHandshake = d
+				case 4:
+					r.Timings.ServerProcessing = d
+				case 5:
+					r.Timings.ContentTransfer = d
+				case 6:
+					r.Timings.Total = d
+				}
+				return nil
+			})
+		case 5:
+			r.RequestLine = string(v.b)
+		case 6:
+			r.Reused = v.n != 0
+		case 7:
+			r.CNAMEs = append(r.CNAMEs, string(v.b))
+		case 8:
+			var rd Redirect
+			err := protoDecode(v.b, func(num int, v protoValue) error {
+				switch num {
+				case 1:
+					rd.From = string(v.b)
+				case 2:
+					rd.To = string(v.b)
+				case 3:
+					rd.HostChanged = v.n != 0
+				case 4:
+					rd.PortChanged = v.n != 0
+				}
+				return nil
+			})
+			r.Redirects = append(r.Redirects, rd)
+			return err
+		case 9:
+			r.TLS = &TLSInfo{}
+			return protoDecode(v.b, func(num int, v protoValue) error {
+				switch num {
+				case 1:
+					r.TLS.NotAfter = time.Unix(v.int(), 0).UTC()
+				case 2:
+					r.TLS.DaysRemaining = int(v.int())
+				case 3:
+					r.TLS.ClientCertRequested = v.n != 0
+				case 4:
+					r.TLS.ClientCertSent = v.n != 0
+				case 5:
+					r.TLS.RenegotiationAttempted = v.n != 0
+				case 6:
+					r.TLS.Verification = time.Duration(v.int())
+				}
+				return nil
+			})
+		case 10:
+			r.RemoteDNS = v.n != 0
+		case 11:
+			r.method = string(v.b)
+		case 12:
+			r.url = string(v.b)
+		case 13:
+			var a HeaderAnomaly
+			err := protoDecode(v.b, func(num int, v protoValue) error {
+				switch num {
+				case 1:
+					a.Code = string(v.b)
+				case 2:
+					a.Description = string(v.b)
+				}
+				return nil
+			})
+			r.HeaderAnomalies = append(r.HeaderAnomalies, a)
+			return err
+		case 14:
+			var w Warning
+			err := protoDecode(v.b, func(num int, v protoValue) error {
+				switch num {
+				case 1:
+					w.Code = string(v.b)
+				case 2:
+					w.Message = string(v.b)
+				}
+				return nil
+			})
+			r.Warnings = append(r.Warnings, w)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("malformed protobuf response: %v", err)
+	}
+	return r, nil
+}
+
+// Protocol buffer wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// protoEncoder writes proto3 fields, leaving out those with zero values.
+type protoEncoder struct {
+	buf []byte
+}
+
+func (e *protoEncoder) key(num int, wire int) {
+	e.varint(uint64(num)<<3 | uint64(wire))
+}
+
+func (e *protoEncoder) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *protoEncoder) uint(num int, v uint64) {
+	if v != 0 {
+		e.key(num, wireVarint)
+		e.varint(v)
+	}
+}
+
+// int writes an int32 or int64 field; negative values take ten bytes,
+// as the schema uses no zigzag encoded types.
+func (e *protoEncoder) int(num int, v int64) {
+	e.uint(num, uint64(v))
+}
+
+func (e *protoEncoder) bool(num int, v bool) {
+	if v {
+		e.uint(num, 1)
+	}
+}
+
+func (e *protoEncoder) string(num int, v string) {
+	if v != "" {
+		e.bytes(num, []byte(v))
+	}
+}
+
+// bytes writes v even when empty, as elements of repeated fields must.
+func (e *protoEncoder) bytes(num int, v []byte) {
+	e.key(num, wireBytes)
+	e.varint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *protoEncoder) message(num int, fields func(*protoEncoder)) {
+	var m protoEncoder
+	fields(&m)
+	e.bytes(num, m.buf)
+}
+
+// protoValue is a decoded field: n for varint and fixed fields, b for
+// length-delimited ones.
+type protoValue struct {
+	n uint64
+	b []byte
+}
+
+func (v protoValue) int() int64 {
+	return int64(v.n)
+}
+
+var errProtoTruncated = errors.New("truncated field")
+
+// protoDecode calls field for every field of the message in b, in order.
+func protoDecode(b []byte, field func(num int, v protoValue) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errProtoTruncated
+		}
+		b = b[n:]
+
+		num := key >> 3
+		if num == 0 || num > math.MaxInt32 {
+			return fmt.Errorf("invalid field number %d", num)
+		}
+
+		var v protoValue
+		switch key & 7 {
+		case wireVarint:
+			if v.n, n = binary.Uvarint(b); n <= 0 {
+				return errProtoTruncated
+			}
+			b = b[n:]
+		case wireFixed64:
+			if len(b) < 8 {
+				return errProtoTruncated
+			}
+			v.n, b = binary.LittleEndian.Uint64(b), b[8:]
+		case wireFixed32:
+			if len(b) < 4 {
+				return errProtoTruncated
+			}
+			v.n, b = uint64(binary.LittleEndian.Uint32(b)), b[4:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || l > uint64(len(b)-n) {
+				return errProtoTruncated
+			}
+			v.b, b = b[n:n+int(l)], b[n+int(l):]
+		default:
+			return fmt.Errorf("unsupported wire type %d", key&7)
+		}
+
+		if err := field(int(num), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// Redirect describes a single hop of a followed redirect chain.
+type Redirect struct {
+	From string
+	To   string
+
+	// HostChanged is set when the hop moves to a different host.
+	HostChanged bool
+
+	// PortChanged is set when the hop moves to a port that is neither
+	// the one it came from nor the default port of its scheme.
+	PortChanged bool
+
+	// Candidates lists every target the response pointed to when they
+	// disagree: several Location headers, or a Refresh header or meta
+	// refresh elsewhere than Location. Only the first Location is
+	// followed.
+	Candidates []RedirectCandidate `json:",omitempty"`
+}
+
+// RedirectCandidate is a redirect target and where it was found:
+// "Location", "Refresh header" or "meta refresh".
+type RedirectCandidate struct {
+	Source string
+	Target string
+}
+
+func (c RedirectCandidate) String() string {
+	return fmt.Sprintf("%s (%s)", c.Target, c.Source)
+}
+
+var (
+	metaRefresh = regexp.MustCompile(`(?is)<meta\s[^>]*http-equiv\s*=\s*["']?refresh["']?[^>]*>`)
+	metaContent = regexp.MustCompile(`(?is)\bcontent\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+)
+
+// conflictingRedirects returns all redirect targets of a 3xx response
+// resolved against base, when there is more than one of them. head is
+// the start of the body.
+func conflictingRedirects(resp *http.Response, head []byte, base *url.URL) []RedirectCandidate {
+	if resp.StatusCode < 300 || resp.StatusCode > 399 {
+		return nil
+	}
+
+	var all []RedirectCandidate
+	add := func(source, target string) {
+		if u, err := base.Parse(strings.TrimSpace(target)); err == nil {
+			target = u.String()
+		}
+		all = append(all, RedirectCandidate{source, target})
+	}
+
+	for _, loc := range resp.Header["Location"] {
+		add("Location", loc)
+	}
+	for _, v := range resp.Header["Refresh"] {
+		if target, ok := refreshURL(v); ok {
+			add("Refresh header", target)
+		}
+	}
+	for _, tag := range metaRefresh.FindAll(head, -1) {
+		m := metaContent.FindSubmatch(tag)
+		if m == nil {
+			continue
+		}
+		if target, ok := refreshURL(string(m[1]) + string(m[2]) + string(m[3])); ok {
+			add("meta refresh", target)
+		}
+	}
+
+	if len(all) < 2 {
+		return nil
+	}
+	for _, c := range all[1:] {
+		if c.Target != all[0].Target {
+			return all
+		}
+	}
+	// the same target sent twice by several Location headers is still
+	// ambiguous
+	if len(resp.Header["Location"]) > 1 {
+		return all
+	}
+	return nil
+}
+
+// refreshURL returns the URL of a Refresh value, "5; url=/next".
+func refreshURL(v string) (string, bool) {
+	i := strings.IndexAny(v, ";,")
+	if i == -1 {
+		return "", false
+	}
+	v = strings.TrimSpace(v[i+1:])
+	if len(v) < 4 || !strings.EqualFold(v[:3], "url") {
+		return "", false
+	}
+	v = strings.TrimSpace(v[3:])
+	if !strings.HasPrefix(v, "=") {
+		return "", false
+	}
+	v = strings.Trim(strings.TrimSpace(v[1:]), `"'`)
+	return v, v != ""
+}
+
+func newRedirect(from, to *url.URL) Redirect {
+	return Redirect{
+		From:        from.String(),
+		To:          to.String(),
+		HostChanged: from.Hostname() != to.Hostname(),
+		PortChanged: portOf(from) != portOf(to) && portOf(to) != defaultPort(to.Scheme),
+	}
+}
+
+// portOf returns the port u connects to, falling back to the default
+// port of its scheme.
+func portOf(u *url.URL) string {
+	if p := u.Port(); p != "" {
+		return p
+	}
+	return defaultPort(u.Scheme)
+}
+
+// effectiveURL returns u the way a browser's address bar shows it once
+// there: scheme and host in lower case, the host in Unicode, without
+// the default port and with at least "/" as path.
+func effectiveURL(u *url.URL) string {
+	e := *u
+	e.Scheme = strings.ToLower(e.Scheme)
+
+	host, port := strings.ToLower(e.Hostname()), e.Port()
+	if h, err := idna.ToUnicode(host); err == nil {
+		host = h
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port != "" && port != defaultPort(e.Scheme) {
+		host += ":" + port
+	}
+	e.Host = host
+
+	if e.Path == "" && e.Opaque == "" {
+		e.Path = "/"
+	}
+	return e.String()
+}
+
+func defaultPort(scheme string) string {
+	switch scheme {
+	case "https":
+		return "443"
+	default:
+		return "80"
+	}
+}
@@ -0,0 +1,164 @@
+package stat
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Render formats resp for output. Formats are "text", the full report
+// of Response.String, and "line", a single line of stable key=value
+// pairs for log aggregation:
+//
+//	GET https://example.com/ 200 dns=12 conn=34 tls=56 server=78 transfer=90 total=270 reused=false
+//
+// Durations are whole milliseconds.
+//
+// "chrome" renders the phases of every hop as Chrome Trace Event Format,
+// a JSON array to load into about:tracing or the DevTools performance
+// panel.
+//
+// "grafana" renders the phase timings as the time series of Grafana's
+// JSON datasources, see RenderGrafana.
+//
+// "json" renders the TraceResult of resp.
+func Render(format string, resp *Response) (string, error) {
+	switch format {
+	case "text":
+		return resp.String(), nil
+	case "line":
+		return renderLine(resp), nil
+	case "chrome":
+		return renderChrome(resp)
+	case "grafana":
+		return RenderGrafana([]*Response{resp})
+	case "json":
+		b, err := json.Marshal(resp.Result())
+		return string(b), err
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func renderLine(resp *Response) string {
+	ms := func(d time.Duration) int64 {
+		return int64(d / time.Millisecond)
+	}
+
+	t := resp.Timings
+	fields := []string{
+		resp.method,
+		resp.url,
+		fmt.Sprint(resp.StatusCode),
+		fmt.Sprintf("dns=%d", ms(t.DNSLookup)),
+		fmt.Sprintf("conn=%d", ms(t.TCPConnection)),
+		fmt.Sprintf("tls=%d", ms(t.TLSHandshake)),
+		fmt.Sprintf("server=%d", ms(t.ServerProcessing)),
+		fmt.Sprintf("transfer=%d", ms(t.ContentTransfer)),
+		fmt.Sprintf("total=%d", ms(t.Total)),
+		fmt.Sprintf("reused=%t", resp.Reused),
+	}
+	return strings.Join(fields, " ")
+}
+
+// traceEvent is a complete event of the Chrome Trace Event Format, times
+// in microseconds.
+type traceEvent struct {
+	Name string                 `json:"name"`
+	Cat  string                 `json:"cat"`
+	Ph   string                 `json:"ph"`
+	Ts   int64                  `json:"ts"`
+	Dur  int64                  `json:"dur"`
+	Pid  int                    `json:"pid"`
+	Tid  int                    `json:"tid"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// renderChrome emits an event per hop, with its phases nested inside,
+// all relative to the start of the first hop.
+func renderChrome(resp *Response) (string, error) {
+	events := []traceEvent{}
+	if len(resp.Hops) == 0 {
+		b, err := json.Marshal(events)
+		return string(b), err
+	}
+
+	us := func(d time.Duration) int64 {
+		return int64(d / time.Microsecond)
+	}
+	origin := resp.Hops[0].Start
+
+	for _, h := range resp.Hops {
+		ts := us(h.Start.Sub(origin))
+		events = append(events, traceEvent{
+			Name: h.Method + " " + h.URL,
+			Cat:  "request",
+			Ph:   "X",
+			Ts:   ts,
+			Dur:  us(h.Timings.Total),
+			Pid:  1,
+			Tid:  1,
+			Args: map[string]interface{}{"status": h.StatusCode},
+		})
+
+		// all but the total, one after the other
+		for _, p := range phases(h.Timings)[:5] {
+			events = append(events, traceEvent{
+				Name: p.Name,
+				Cat:  "phase",
+				Ph:   "X",
+				Ts:   ts,
+				Dur:  us(p.D),
+				Pid:  1,
+				Tid:  1,
+			})
+			ts += us(p.D)
+		}
+	}
+
+	b, err := json.MarshalIndent(events, "", "  ")
+	return string(b), err
+}
+
+// GrafanaSeries is a time series in the shape the JSON and SimpleJSON
+// datasources of Grafana expect, each datapoint a value and a Unix time
+// in milliseconds.
+type GrafanaSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// RenderGrafana renders the phase timings of resps, in milliseconds, as
+// a series per phase named as in the metrics of MetricsSink. Each trace
+// is stamped with the time it started, its run time when traces are
+// scheduled, so repeated traces of a target make a series over time.
+// Responses without hops, such as those of remote vantage points, have
+// no start time and are left out.
+func RenderGrafana(resps []*Response) (string, error) {
+	var traced []*Response
+	for _, resp := range resps {
+		if len(resp.Hops) > 0 {
+			traced = append(traced, resp)
+		}
+	}
+	sort.SliceStable(traced, func(i, j int) bool {
+		return traced[i].Hops[0].Start.Before(traced[j].Hops[0].Start)
+	})
+
+	series := make([]GrafanaSeries, len(metricPhases))
+	for i, name := range metricPhases {
+		series[i] = GrafanaSeries{Target: name, Datapoints: [][2]float64{}}
+	}
+	for _, resp := range traced {
+		at := float64(resp.Hops[0].Start.UnixNano() / int64(time.Millisecond))
+		for i, p := range phases(resp.Timings) {
+			ms := float64(p.D) / float64(time.Millisecond)
+			series[i].Datapoints = append(series[i].Datapoints, [2]float64{ms, at})
+		}
+	}
+
+	b, err := json.Marshal(series)
+	return string(b), err
+}
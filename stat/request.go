@@ -1,7 +1,8 @@
 package stat
 
 import (
-	"crypto/tls"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -10,10 +11,10 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
-	"golang.org/x/net/http2"
 )
 
 type Request struct {
@@ -28,23 +29,309 @@ type Request struct {
 	OnlyHeader      bool
 	Insecure        bool
 	ShowVersion     bool
+	Verbose         bool
+
+	// ShowTLS reports the TLS version, cipher suite and ALPN protocol,
+	// the subject, issuer, expiry and names of the certificate, and the
+	// chain presented with it. With Insecure, it tells whether
+	// verification would have failed.
+	ShowTLS bool
 
 	MaxRedirects int
+
+	// Retries starts the trace over from the first hop up to that many
+	// times when a hop of the redirect chain fails to resolve, connect,
+	// complete the TLS handshake or in time. Other failures, such as
+	// MaxRedirects or ExpiryFailDays, would fail again and are final.
+	Retries int
+
+	// IncludeBody captures up to MaxBodyBytes of the final response
+	// body into the Response. CaptureTail captures its last bytes
+	// instead of its first, reading the body within the limits of
+	// StreamMaxDuration and StreamMaxBytes.
+	IncludeBody  bool
+	MaxBodyBytes int64
+	CaptureTail  bool
+
+	// BodyJSONPath extracts a value from a JSON body, read up to
+	// MaxBodyBytes, with a JSONPath expression such as $.items[0].id;
+	// $, .name, ['name'], [n], [*], .* and ..name are supported.
+	// Assertions on body then see the value found rather than the
+	// whole body.
+	BodyJSONPath string
+
+	// Stream records the arrival time of each chunk of the final
+	// response body, reading for at most StreamMaxDuration or
+	// StreamMaxBytes (10s and 1MB when left zero).
+	Stream            bool
+	StreamMaxDuration time.Duration
+	StreamMaxBytes    int64
+
+	// SSE reads the final response as a stream of server-sent events,
+	// for up to SSEMaxEvents events or SSEMaxDuration (5 events and
+	// 10s when left zero), and reports when they arrived.
+	SSE            bool
+	SSEMaxEvents   int
+	SSEMaxDuration time.Duration
+
+	// ShowCNAME reports the CNAME chain of the URL host, as answered
+	// by DNSServer ("host:port", the first nameserver of
+	// /etc/resolv.conf when empty).
+	ShowCNAME bool
+	DNSServer string
+
+	// ShowTTL reports the TTL of each record the URL host resolves
+	// through, as answered by DNSServer, and warns of those below
+	// ExpectTTLMin or above ExpectTTLMax: too low a TTL defeats the
+	// caching of clients, too high a one delays failover. A zero bound
+	// is not checked.
+	ShowTTL      bool
+	ExpectTTLMin time.Duration
+	ExpectTTLMax time.Duration
+
+	// DNSTimeout bounds resolving the URL host, so that an unresponsive
+	// DNS server fails the trace early, leaving the rest of Timeout
+	// to the connection. Zero only bounds it by Timeout.
+	DNSTimeout time.Duration
+
+	// Resolvers are queried in parallel for the URL host, each an IP
+	// address with an optional port. The request connects to the
+	// address of the fastest answer; when none answers, the system
+	// resolver is used.
+	Resolvers []string
+
+	// SOCKS5 routes the request through the SOCKS5 proxy at this
+	// "host:port", Tor's 127.0.0.1:9050 for one. The proxy resolves
+	// the URL host, which leaves no DNS lookup to time: the connection
+	// phase covers the whole path through the proxy. Resolvers and
+	// proxies from the environment are not used.
+	SOCKS5 string
+
+	// BasicAuth, as "user:password", and BearerToken authorize the
+	// request, one of them at most and without an Authorization line
+	// in HTTPHeaders. Like the Authorization, Cookie and Host lines of
+	// HTTPHeaders, they are not sent to other hosts than that of URL,
+	// which redirects and sub-resources may lead to.
+	BasicAuth   string
+	BearerToken string
+
+	// Cookies is sent as the Cookie header, in its "k=v; k2=v2" form.
+	// A Cookie passed in HTTPHeaders takes precedence.
+	Cookies string
+
+	// Referer and Origin are sent as such, to test anti-hotlinking and
+	// CORS. Headers of the same name in HTTPHeaders take precedence.
+	// When the request carries an Origin, the CORS headers of the
+	// response are checked against it.
+	Referer string
+	Origin  string
+
+	// CheckCSP parses the Content-Security-Policy headers of the final
+	// response, flags unsafe sources and rates the policy.
+	CheckCSP bool
+
+	// CacheKey reports the parts of the request caches key the final
+	// response on, as told by its Vary header.
+	CacheKey bool
+
+	// Preflight sends the CORS preflight a browser would send from
+	// Origin before the request, and reports whether it lets the
+	// request through. PreflightOnly stops there: the trace is that of
+	// the preflight.
+	Preflight     bool
+	PreflightOnly bool
+
+	// IPVersion restricts connections to IPv4 or IPv6, zero allows both.
+	IPVersion int
+
+	// HappyEyeballs races new connections over IPv6 and IPv4 as RFC
+	// 8305 describes, and reports which family won and how long each
+	// took to connect. It has no effect with IPVersion set, and
+	// DNSTimeout does not apply.
+	HappyEyeballs bool
+
+	// HostHeader is sent as the Host header while DNS, the connection
+	// and SNI keep using the URL host. It takes precedence over a Host
+	// passed in HTTPHeaders.
+	HostHeader string
+
+	// Timeout bounds the whole trace, redirects included, and Deadline
+	// sets an absolute time it must be done by. When both are set the
+	// earlier one applies; zero values leave the trace unbounded.
+	// NewRequest sets a Timeout of 30s.
+	Timeout  time.Duration
+	Deadline time.Time
+
+	// Samples is how many times Tracer.Sample traces the request, and
+	// TotalBudget caps the time spent on all of them, or on all the
+	// URLs of Tracer.Batch.
+	Samples     int
+	TotalBudget time.Duration
+
+	// MaxConcurrency is the highest number of traces Tracer.Ramp,
+	// Tracer.Sitemap and Tracer.Batch run at once.
+	MaxConcurrency int
+
+	// MaxURLs caps the URLs of a sitemap Tracer.Sitemap traces.
+	MaxURLs int
+
+	// RefusePortChange refuses to follow a redirect that moves to a
+	// port other than the current one or the default for its scheme.
+	RefusePortChange bool
+
+	// ExpiryWarnDays warns, and ExpiryFailDays fails the trace, when
+	// the certificate expires within that many days; ExpiryWarnDays
+	// also warns of the intermediates presented with it. Zero disables
+	// the check; NewRequest warns at 14 days and never fails.
+	ExpiryWarnDays int
+	ExpiryFailDays int
+
+	// ExpectCertFor checks whether the certificate presented is valid
+	// for this host name as well, whatever host was connected to.
+	ExpectCertFor string
+
+	// RequireTLS checks the negotiated connection against a set of
+	// requirements and reports a verdict.
+	RequireTLS TLSRequirements
+
+	// HTTP2 overrides the settings advertised on HTTP/2 connections.
+	HTTP2 HTTP2Settings
+
+	// ForceHTTP restricts the protocol to ForceHTTP1 or ForceHTTP2,
+	// the only one offered by ALPN; forcing HTTP/2 fails the trace when
+	// the server does not agree to it, and requires https. ForceHTTP3
+	// traces over QUIC instead, also over https only, failing when the
+	// server does not answer QUIC; see Response.AltSvc for whether a
+	// server offers it.
+	ForceHTTP string
+
+	// Subresources lists the sub-resources the final response declares
+	// for preload, modulepreload or prefetch, in Link headers and in
+	// link tags of the first 64KB of an HTML body. TraceSubresources
+	// traces up to that many of them too, 20 at most, a few at a time
+	// once the page is read as a browser would, for a fuller picture of
+	// the cost of loading it.
+	Subresources      bool
+	TraceSubresources int
+
+	// HTTP2Streams reports the HTTP/2 stream of each hop and how many
+	// streams shared its connection, to observe multiplexing between
+	// traces sharing a Tracer.
+	HTTP2Streams bool
+
+	// UploadRateLimit throttles sending PostBody to that many bytes
+	// per second, to see how servers cope with slow clients. Zero
+	// sends it as fast as the connection allows.
+	UploadRateLimit int64
+
+	// Conn, when set, provides the connection to send the request over,
+	// connected and for https URLs with TLS established already. Only
+	// the HTTP exchange is then traced: DNS lookup, TCP connection and
+	// TLS handshake are reported as zero, as they are out of sight.
+	// Options configuring those, such as Insecure or SOCKS5, have no
+	// effect. Conn is called again for each redirect followed.
+	Conn func(ctx context.Context) (net.Conn, error)
+
+	// KeyLogFile appends the TLS secrets of every connection to this
+	// file, for Wireshark to decrypt a capture of the trace. When empty
+	// the SSLKEYLOGFILE environment variable is honored. Anyone able
+	// to read the file can decrypt the traffic: only use it to debug.
+	KeyLogFile string
+
+	// EarlyData asks to send the request as TLS 1.3 early data (0-RTT)
+	// on resumed sessions, and enables session resumption to that end.
+	// Early data can be replayed by an attacker, servers should only
+	// accept it for idempotent requests.
+	//
+	// crypto/tls does not implement sending early data, so it is never
+	// attempted; the report still tells whether the session resumed,
+	// which is what 0-RTT builds on.
+	EarlyData bool
+
+	// AbortOnStatusClass stops the trace as soon as the status line is
+	// read when the status is in one of these classes, 5 for 5xx, 4
+	// for 4xx and so on. The body is left unread and the connection
+	// closed rather than pooled.
+	AbortOnStatusClass []int
+
+	// ShowPercentages reports each phase as a share of the total time
+	// besides its duration.
+	ShowPercentages bool
+
+	// ClockSkew reports how far the server clock, as told by the Date
+	// header, is ahead of ours. The Date header only has a resolution
+	// of one second.
+	ClockSkew bool
+
+	// TCPFastOpen sends the start of the request along with the SYN of
+	// new connections, saving a round trip to servers that support it.
+	// It needs Linux 4.11 or later with net.ipv4.tcp_fastopen allowing
+	// clients (bit 1), and a cookie from an earlier connection to the
+	// server: the first connection never uses it. Elsewhere connections
+	// are made as usual and Fast Open is reported unavailable.
+	TCPFastOpen bool
+
+	// TCPInfo reports the kernel's statistics of the connection once
+	// the response is read: round-trip time and retransmissions. Only
+	// Linux exposes them.
+	TCPInfo bool
+
+	// Assertions are evaluated against the final response, see
+	// Assertion for their syntax.
+	Assertions []string
+
+	// AuditHeaders fetches the final URL a second time over raw
+	// HTTP/1.1 and reports header constructs that hint at request
+	// smuggling or response splitting. Only GET and HEAD are audited.
+	AuditHeaders bool
+
+	// Shadow sends the same request to this URL as well, a candidate
+	// backend for one, concurrently with the traced one. Its timings,
+	// and whether its status, ShadowHeaders and body match, end up in
+	// Response.Shadow; its failure does not fail the trace.
+	Shadow string
+
+	// ShadowHeaders are the response headers compared with the shadow,
+	// Content-Type when nil.
+	ShadowHeaders []string
 }
 
-func NewRequest(path string) *Request {
+func NewRequest(path string) (*Request, error) {
+	u, err := parseURL(path)
+	if err != nil {
+		return nil, err
+	}
 	return &Request{
-		URL:             parseURL(path),
+		URL:             u,
 		HTTPMethod:      "GET",
 		FollowRedirects: true,
 		MaxRedirects:    2,
-	}
+		MaxBodyBytes:    64 << 10,
+		ExpiryWarnDays:  14,
+		Timeout:         30 * time.Second,
+	}, nil
 }
 
-func (r Request) visit(w *Response) {
-	req := r.cook()
+func (r Request) visit(ctx context.Context, t *Tracer, w *Response) error {
+	if (r.ForceHTTP == ForceHTTP2 || r.ForceHTTP == ForceHTTP3) && r.URL.Scheme != "https" {
+		return failedf("HTTP/%s was forced, which requires https, but %s is not", r.ForceHTTP, r.URL)
+	}
+	req, err := r.cook()
+	if err != nil {
+		return err
+	}
+
+	if r.ShowCNAME {
+		r.lookupCNAME(ctx, w)
+	}
+	if r.ShowTTL {
+		r.lookupTTL(ctx, w)
+	}
 
 	var t0, t1, t2, t3, t4 time.Time
+	var wroteHeaders, wroteRequest, gotContinue time.Time
+	var connErr error
+	var conn net.Conn
 
 	trace := &httptrace.ClientTrace{
 		DNSStart: func(_ httptrace.DNSStartInfo) { t0 = time.Now() },
@@ -57,46 +344,80 @@ func (r Request) visit(w *Response) {
 		},
 		ConnectDone: func(net, addr string, err error) {
 			if err != nil {
-				makePanic("Unable to connect to host %v: %v", addr, err)
+				// runs on the transport's dialing goroutine, leave
+				// the failure to client.Do
+				connErr = fmt.Errorf("Unable to connect to host %v: %w", addr, err)
+				return
 			}
 			t2 = time.Now()
 
 			w.report("Connected to %s\n", addr)
 		},
-		GotConn:              func(_ httptrace.GotConnInfo) { t3 = time.Now() },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t3 = time.Now()
+			conn = info.Conn
+			w.Reused = info.Reused
+			if info.Reused || r.Conn != nil {
+				// pooled or provided connection, nothing was resolved
+				// or dialed
+				t0, t1, t2 = t3, t3, t3
+			}
+		},
+		WroteHeaders:         func() { wroteHeaders = time.Now() },
+		Got100Continue:       func() { gotContinue = time.Now() },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { wroteRequest = time.Now() },
 		GotFirstResponseByte: func() { t4 = time.Now() },
 	}
 
-	req = req.WithContext(httptrace.WithClientTrace(context.Background(), trace))
+	var collectResolvers func() []ResolverResult
+	if host := r.URL.Hostname(); len(r.Resolvers) > 0 && r.SOCKS5 == "" && r.Conn == nil && net.ParseIP(host) == nil {
+		qtype := uint16(dnsTypeA)
+		if r.IPVersion == IPv6 {
+			qtype = dnsTypeAAAA
+		}
 
-	tr := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
+		start := time.Now()
+		var fastest *ResolverResult
+		fastest, collectResolvers = resolveParallel(ctx, r.Resolvers, host, qtype)
+		if fastest != nil {
+			// the dial skips resolution, time it here instead
+			t0, t1 = start, start.Add(fastest.Duration)
+			ctx = withPinnedAddr(ctx, host, fastest.Addrs[0])
+		}
 	}
 
-	switch r.URL.Scheme {
-	case "https":
-		host, _, err := net.SplitHostPort(req.Host)
-		if err != nil {
-			host = req.Host
-		}
+	var eyeballs *eyeballsRace
+	if r.HappyEyeballs && r.IPVersion == 0 {
+		eyeballs = &eyeballsRace{}
+		ctx = withEyeballs(ctx, eyeballs)
+	}
 
-		tr.TLSClientConfig = &tls.Config{
-			ServerName:         host,
-			InsecureSkipVerify: r.Insecure,
-			Certificates:       readClientCert(r.ClientCertFile),
-		}
+	var h2 *HTTP2Info
+	if r.HTTP2Streams {
+		h2 = &HTTP2Info{}
+		ctx = withHTTP2Info(ctx, h2)
+	}
+
+	cr := &certRequest{}
+	ctx = withCertRequest(ctx, cr)
 
-		// Because we create a custom TLSClientConfig, we have to opt-in to HTTP/2.
-		// See https://github.com/golang/go/issues/14275
-		err = http2.ConfigureTransport(tr)
+	req = req.WithContext(httptrace.WithClientTrace(ctx, trace))
+	if r.UploadRateLimit > 0 && req.Body != nil {
+		req.Body = &throttledReader{r: req.Body, rate: r.UploadRateLimit, done: ctx.Done()}
+		req.GetBody = nil
+	}
+
+	var tr *transport
+	if r.Conn != nil {
+		tr, err = connTransport(r.Conn, r.HTTP2, r.HTTP2Streams)
 		if err != nil {
-			makePanic("Failed to prepare transport for HTTP/2: %v", err)
+			return err
 		}
+		defer tr.CloseIdleConnections()
+	} else if tr, err = t.transport(&r); err != nil {
+		return err
 	}
+	renegotiations := atomic.LoadInt32(&tr.renegotiations)
 
 	client := &http.Client{
 		Transport: tr,
@@ -109,11 +430,105 @@ func (r Request) visit(w *Response) {
 
 	resp, err := client.Do(req)
 	if err != nil {
-		makePanic("Failed to read response: %v", err)
+		if connErr != nil && conn == nil {
+			// an address failed and no other connected
+			return failf(connErr, "%v", connErr)
+		}
+		var dnsErr *dnsTimeoutError
+		if errors.As(err, &dnsErr) {
+			return failf(err, "DNS lookup timed out, DNSTimeout of %s exceeded", dnsErr.timeout)
+		}
+		if isRenegotiation(err) {
+			return &TraceError{Kind: ErrorTLS, Msg: fmt.Sprintf("Server attempted TLS renegotiation, which is refused: %v", err), Err: err}
+		}
+		if r.AuditHeaders && r.auditable() {
+			// the client refuses some malformed responses outright,
+			// the raw read may tell why
+			if found, aerr := r.auditHeaders(ctx, t.policy()); aerr == nil && len(found) > 0 {
+				return failf(err, "Failed to read response: %v (%s)", err, joinAnomalies(found))
+			}
+		}
+		return failf(err, "Failed to read response: %v", err)
+	}
+
+	if collectResolvers != nil {
+		reportResolvers(w, collectResolvers())
+	}
+	if r.ForceHTTP == ForceHTTP2 && resp.ProtoMajor != 2 {
+		resp.Body.Close()
+		return failedf("Server did not agree to HTTP/2, it answered over %s", resp.Proto)
+	}
+
+	var read bodyRead
+	var decoded *decodingBody
+	aborted := r.abortsOn(resp.StatusCode)
+	follow := !aborted && r.FollowRedirects && isRedirect(resp)
+	if !aborted && req.Method != http.MethodHead {
+		decoded = decodeBody(resp)
 	}
+	switch {
+	case aborted:
+		// closing the unread body closes the connection
+		w.Aborted = true
+		read.msg = fmt.Sprintf("Aborted early due to %dxx status", resp.StatusCode/100)
+	case follow:
+		// only the final response is worth reading in full
+		read, err = drainRedirectBody(resp)
+	case r.SSE:
+		w.Events, err = readEvents(resp.Body, t4, r.SSEMaxEvents, r.SSEMaxDuration)
+		if err != nil {
+			break
+		}
+		w.Events.ContentType = isEventStream(resp.Header.Get("Content-Type"))
+		read = bodyRead{msg: "Event stream read", size: w.Events.Bytes}
+	default:
+		var capture int64
+		if r.IncludeBody || r.BodyJSONPath != "" {
+			capture = r.MaxBodyBytes
+		}
+
+		var stream *streamReader
+		if r.Stream || r.CaptureTail {
+			// a tail is only worth the wait within the stream limits
+			stream = newStreamReader(resp.Body, t4, r.StreamMaxBytes, r.StreamMaxDuration)
+			resp.Body = stream
+		}
+
+		var head int64
+		if r.Subresources || r.TraceSubresources > 0 {
+			head = maxSubresourceScan
+		}
+		read, err = readResponseBody(req, resp, capture, head, r.CaptureTail)
+		if err != nil {
+			break
+		}
+		if r.IncludeBody {
+			w.Body = read.body
+		}
+		if r.CaptureTail && read.body != nil {
+			read.msg = fmt.Sprintf("Body tail captured, %d bytes streamed", read.size)
+			if stream.stream.Stopped != "" {
+				read.msg += ", " + stream.stream.Stopped
+			}
+		}
+		w.bodySum = hex.EncodeToString(read.sum)
 
-	bodyMsg := readResponseBody(req, resp)
+		if len(read.head) > 0 {
+			w.ContentType = sniffContentType(resp.Header.Get("Content-Type"), read.head)
+		}
+
+		if r.Stream {
+			w.Stream = stream.stream
+		}
+	}
 	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if r.BodyJSONPath != "" && !follow {
+		w.BodyJSON = extractJSONPath(r.BodyJSONPath, read.body)
+	}
 
 	t5 := time.Now() // after read body
 	if t0.IsZero() {
@@ -121,9 +536,72 @@ func (r Request) visit(w *Response) {
 		t0 = t1
 	}
 
+	if req.Host != r.URL.Host {
+		w.report("Host header: %s (connected to %s)", req.Host, r.URL.Host)
+	}
+
+	// the request line as sent; HTTP/2 has none, but carries the same
+	// method and path as pseudo-headers
+	w.RequestLine = fmt.Sprintf("%s %s %s", req.Method, req.URL.RequestURI(), resp.Proto)
+	w.report("Request: %s", w.RequestLine)
+
+	switch {
+	case r.Conn != nil:
+		w.report("Connection: provided, transport timings unavailable")
+	case w.Reused:
+		w.report("Connection: reused")
+	default:
+		w.report("Connection: new")
+	}
+	proto := resp.Proto
+	if resp.TLS != nil && resp.TLS.NegotiatedProtocol != "" {
+		proto += ", ALPN " + resp.TLS.NegotiatedProtocol
+	}
+	if resp.ProtoMajor == 3 {
+		proto += ", over QUIC"
+	}
+	w.report("Protocol: %s", proto)
+	if eyeballs != nil {
+		w.HappyEyeballs = eyeballs.snapshot()
+		if w.HappyEyeballs != nil {
+			w.report("Happy Eyeballs: %s", w.HappyEyeballs)
+		}
+	}
+	if tr.keyLog != nil && resp.TLS != nil {
+		w.warn(WarnTLSKeyLog, "TLS session secrets are logged to %s, for debugging only", tr.keyLog.name)
+		if err := tr.keyLog.lastErr(); err != nil {
+			w.warn(WarnTLSKeyLogFailed, "writing TLS key log failed: %v", err)
+		}
+	}
+	if r.SOCKS5 != "" {
+		w.RemoteDNS = true
+		w.report("Proxy: SOCKS5 %s, DNS resolved by the proxy", r.SOCKS5)
+	}
+
+	if h2 != nil {
+		w.HTTP2 = nil
+		switch {
+		case resp.ProtoMajor != 2:
+			w.report("HTTP/2 stream: n/a, connection used HTTP/%d.%d", resp.ProtoMajor, resp.ProtoMinor)
+		case h2.StreamID == 0:
+			w.report("HTTP/2 stream: unknown")
+		default:
+			w.HTTP2 = h2
+			w.report("HTTP/2 stream: %s", h2)
+		}
+	}
+
 	// print status line and headers
 	w.report("HTTP/%d.%d %s", resp.ProtoMajor, resp.ProtoMinor, resp.Status)
 
+	if r.Verbose && !r.HTTP2.isZero() {
+		if resp.ProtoMajor == 2 {
+			w.report("HTTP/2 settings: %s", r.HTTP2)
+		} else {
+			w.report("HTTP/2 settings not applied, connection used HTTP/%d.%d", resp.ProtoMajor, resp.ProtoMinor)
+		}
+	}
+
 	names := make([]string, 0, len(resp.Header))
 	for k := range resp.Header {
 		names = append(names, k)
@@ -134,11 +612,190 @@ func (r Request) visit(w *Response) {
 		w.report("%s: %s", k, strings.Join(resp.Header[k], ","))
 	}
 
-	if bodyMsg != "" {
-		w.report("%s", bodyMsg)
+	if read.msg != "" {
+		w.report("%s", read.msg)
+	}
+	if w.BodyJSON != nil {
+		w.report("Body JSON: %s", w.BodyJSON)
+	}
+
+	if !follow {
+		w.AltSvc = parseAltSvc(resp.Header["Alt-Svc"])
+		reportAltSvc(w, resp.ProtoMajor)
+	}
+
+	if (r.Subresources || r.TraceSubresources > 0) && !follow {
+		w.Subresources = findSubresources(r.URL, resp.Header, read.head)
+		if r.TraceSubresources == 0 {
+			w.report("Sub-resources: %d declared", len(w.Subresources))
+			reportSubresources(w)
+		}
+	}
+
+	candidates := conflictingRedirects(resp, read.head, r.URL)
+	if candidates != nil {
+		o := make([]string, len(candidates))
+		for i, c := range candidates {
+			o[i] = c.String()
+		}
+		w.warn(WarnConflictingRedirect, "conflicting redirect targets: %s", strings.Join(o, ", "))
+	}
+
+	if resp.TLS != nil {
+		w.TLS = newTLSInfo(resp.TLS, time.Now())
+		w.TLS.RenegotiationAttempted = read.renegotiation
+		w.TLS.FalseStart = falseStart(resp.TLS, r.Conn != nil)
+		w.TLS.ClientCertRequested = atomic.LoadInt32(&cr.requested) == 1
+		w.TLS.ClientCertSent = atomic.LoadInt32(&cr.sent) == 1
+		if tr.verifier != nil && len(resp.TLS.PeerCertificates) > 0 {
+			w.TLS.Verification = tr.verifier.took(resp.TLS.PeerCertificates[0])
+		}
+
+		if atomic.LoadInt32(&tr.renegotiations) != renegotiations {
+			// the server renegotiated to ask for the certificate;
+			// with concurrent traces on tr this may be another's
+			w.TLS.ClientCertRequested = true
+			w.TLS.RenegotiationAttempted = true
+			w.TLS.ClientCertSent = r.ClientCertFile != ""
+		}
+
+		switch {
+		case w.TLS.ClientCertSent:
+			w.report("Client certificate requested and sent")
+		case w.TLS.ClientCertRequested:
+			w.report("Client certificate requested, none sent")
+		}
+		if r.EarlyData {
+			w.TLS.Resumed = resp.TLS.DidResume
+			w.TLS.EarlyData = EarlyDataNotAttempted
+			w.report("0-RTT: %s (session resumed: %t)", w.TLS.EarlyData, w.TLS.Resumed)
+		}
+		if r.Insecure && len(resp.TLS.PeerCertificates) > 0 {
+			name := resp.TLS.ServerName
+			if name == "" {
+				name = r.URL.Hostname()
+			}
+			if err := verifyChain(resp.TLS.PeerCertificates, name); err != nil {
+				w.TLS.VerifyError = err.Error()
+			}
+		}
+		if r.ShowTLS {
+			reportTLS(w)
+		}
+		if r.Verbose {
+			w.report("TLS handshake: %s", w.TLS.Handshake)
+		}
+		if r.ExpectCertFor != "" {
+			r.checkCertFor(w, resp.TLS)
+		}
+		if !follow {
+			if err := r.checkExpiry(w); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r.PostBody != "" && !wroteHeaders.IsZero() && !wroteRequest.IsZero() {
+		w.Upload = &Upload{
+			Bytes:    int64(len(r.PostBody)),
+			Duration: wroteRequest.Sub(wroteHeaders),
+		}
+		if !gotContinue.IsZero() {
+			w.Upload.Continue = gotContinue.Sub(wroteHeaders)
+		}
+		w.report("Upload: %s", w.Upload)
+	}
+
+	w.Sizes = newSizes(resp, read.size)
+	w.report("Size: %s", w.Sizes)
+	if decoded != nil && !follow {
+		w.Compression = newCompression(decoded.encoding, decoded.wire.n, read.size)
+		w.report("Compression: %s", w.Compression)
+		if w.Compression.Ineffective {
+			w.warn(WarnCompressionIneffective, "%s compression ratio is %.2f, the body may be compressed already",
+				w.Compression.Encoding, w.Compression.Ratio)
+		}
+	}
+	if w.Stream != nil {
+		w.report("Stream: %s", w.Stream)
+	}
+	if w.Events != nil {
+		if !w.Events.ContentType {
+			w.warn(WarnSSEContentType, "event stream served as %q rather than text/event-stream", resp.Header.Get("Content-Type"))
+		}
+		w.report("Events: %s", w.Events)
+		for i, ev := range w.Events.Events {
+			if i == maxReportedEvents {
+				break
+			}
+			w.report("  %s %s: %s", fmtms(ev.Offset), ev.Type, ev.Data)
+		}
+	}
+	if ct := w.ContentType; ct != nil && ct.Mismatch {
+		w.warn(WarnContentTypeMismatch, "%s", ct)
+	}
+
+	if !r.RequireTLS.isZero() {
+		w.TLSCompliance = checkTLS(r.RequireTLS, resp.TLS)
+		w.report("%s", w.TLSCompliance)
+		if !w.TLSCompliance.Compliant {
+			w.warn(WarnTLSNonCompliant, "connection does not meet the TLS requirements")
+		}
+	}
+
+	if r.AuditHeaders && !follow {
+		r.reportAnomalies(ctx, t.policy(), w)
+	}
+
+	w.KeepAlive = newKeepAlive(resp)
+	w.report("Keep-alive: %s", w.KeepAlive)
+
+	if r.CheckCSP {
+		w.CSP = newCSP(resp.Header)
+		reportCSP(w)
+	}
+
+	if r.CacheKey {
+		w.Cache = newCacheInfo(req, r.URL, resp.Header)
+		w.report("Cache: %s", w.Cache)
+		for _, v := range w.Cache.Vary {
+			if v.Sent {
+				w.report("  %s: %s", v.Name, v.Value)
+			} else {
+				w.report("  %s: not sent", v.Name)
+			}
+		}
+	}
+
+	if origin := req.Header.Get("Origin"); origin != "" {
+		credentials := req.Header.Get("Cookie") != "" || req.Header.Get("Authorization") != ""
+		w.CORS = checkCORS(origin, credentials, resp.Header)
+		w.report("CORS: %s", w.CORS)
+		if w.CORS.Allowed && w.CORS.AllowOrigin != "*" && !w.CORS.VaryOrigin {
+			w.warn(WarnCORSVaryMissing, "allowed origin is echoed without Vary: Origin, caches may serve it to other origins")
+		}
+	}
+
+	if r.ClockSkew {
+		sent := wroteRequest
+		if sent.IsZero() {
+			sent = t3
+		}
+		reportClockSkew(w, resp.Header, sent, t4)
+	}
+
+	if conn != nil {
+		w.LocalAddr = conn.LocalAddr().String()
+	}
+	if r.TCPInfo && conn != nil {
+		w.TCPInfo = newTCPInfo(conn)
+		w.report("TCP: %s", w.TCPInfo)
 	}
 
 	fmta := func(d time.Duration) string {
+		if r.ShowPercentages {
+			return fmt.Sprintf("%dms (%.0f%%)", int(d/time.Millisecond), percentOf(d, w.Timings.Total))
+		}
 		return fmt.Sprintf("%dms", int(d/time.Millisecond))
 	}
 
@@ -146,42 +803,136 @@ func (r Request) visit(w *Response) {
 		return fmt.Sprintf("%dms", int(d/time.Millisecond))
 	}
 
-	w.report("DNS lookup: %s", fmta(t1.Sub(t0)))        // dns lookup
-	w.report("TCP connection: %s", fmta(t2.Sub(t1)))    // tcp connection
-	w.report("TLS handshake: %s", fmta(t3.Sub(t2)))     // tls handshake
-	w.report("Server processing: %s", fmta(t4.Sub(t3))) // server processing
-	w.report("Content transfer: %s", fmta(t5.Sub(t4)))  // content transfer
+	w.StatusCode = resp.StatusCode
+	w.method, w.url = req.Method, r.URL.String()
+	w.FinalURL = effectiveURL(r.URL)
+	w.Proto = resp.Proto
+	w.Header = resp.Header
+	w.Timings = Timings{
+		DNSLookup:        t1.Sub(t0),
+		TCPConnection:    t2.Sub(t1),
+		TLSHandshake:     t3.Sub(t2),
+		ServerProcessing: t4.Sub(t3),
+		ContentTransfer:  t5.Sub(t4),
+		Total:            t5.Sub(t0),
+	}
+	w.Percentages = w.Timings.Percentages()
+	w.Hops = append(w.Hops, Hop{
+		Start:      t0,
+		Method:     req.Method,
+		URL:        w.url,
+		StatusCode: resp.StatusCode,
+		Proto:      resp.Proto,
+		Timings:    w.Timings,
+	})
+
+	if r.TCPFastOpen && conn != nil {
+		reportFastOpen(w, conn)
+	}
+
+	w.report("DNS lookup: %s", fmta(w.Timings.DNSLookup))
+	if resp.ProtoMajor == 3 {
+		w.report("QUIC handshake: %s", fmta(w.Timings.TLSHandshake))
+	} else {
+		w.report("TCP connection: %s", fmta(w.Timings.TCPConnection))
+		w.report("TLS handshake: %s", fmta(w.Timings.TLSHandshake))
+	}
+	if w.TLS != nil {
+		w.report("  Certificate verification: %s", fmta(w.TLS.Verification))
+	}
+	w.report("Server processing: %s", fmta(w.Timings.ServerProcessing))
+	w.report("Content transfer: %s", fmta(w.Timings.ContentTransfer))
 
-	w.report("\nTotal: %s", fmtb(t5.Sub(t0)))
+	w.report("\nTotal: %s", fmtb(w.Timings.Total))
 
-	if r.FollowRedirects && isRedirect(resp) {
+	if follow {
 		loc, err := resp.Location()
 		if err != nil {
 			if err == http.ErrNoLocation {
 				// 30x but no Location to follow, give up.
-				return
+				return nil
 			}
-			makePanic("Unable to follow redirect: %v", err)
+			return failedf("Unable to follow redirect: %v", err)
 		}
 
 		w.redirectsFollowed++
 		if w.redirectsFollowed > r.MaxRedirects {
-			makePanic("Maximum number of redirects (%d) followed", r.MaxRedirects)
+			return failedf("Maximum number of redirects (%d) followed", r.MaxRedirects)
+		}
+
+		if loc.Fragment == "" {
+			// browsers keep the fragment across redirects without one
+			loc.Fragment = r.URL.Fragment
+		}
+
+		hop := newRedirect(r.URL, loc)
+		hop.Candidates = candidates
+		w.Redirects = append(w.Redirects, hop)
+		if hop.HostChanged {
+			w.report("Redirect changes host to %s", loc.Hostname())
+			// as net/http does, credentials stay with their host
+			r.dropCredentials()
+		}
+		if hop.PortChanged {
+			w.report("Redirect changes port to :%s", portOf(loc))
+			if r.RefusePortChange {
+				return failedf("Refusing to follow redirect to %s: port changed to :%s", loc, portOf(loc))
+			}
 		}
 
 		r.URL = loc
 		w.report("\n")
-		r.visit(w)
+		return r.visit(ctx, t, w)
+	}
+	return nil
+}
+
+// abortsOn reports whether the trace stops at a response with status.
+func (r *Request) abortsOn(status int) bool {
+	for _, class := range r.AbortOnStatusClass {
+		if status/100 == class {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuth checks r authorizes its request one way at most.
+func validateAuth(r *Request) error {
+	if r.BasicAuth == "" && r.BearerToken == "" {
+		return nil
+	}
+	if r.BasicAuth != "" && r.BearerToken != "" {
+		return invalidf("Only one of BasicAuth and BearerToken may be given")
+	}
+	if r.BasicAuth != "" && !strings.Contains(r.BasicAuth, ":") {
+		return invalidf("Invalid BasicAuth, expected \"user:password\"")
+	}
+	for _, h := range r.HTTPHeaders {
+		if k, _ := headerKeyValue(h); strings.EqualFold(k, "authorization") {
+			return invalidf("An Authorization header cannot be sent along with BasicAuth or BearerToken")
+		}
+	}
+	return nil
+}
+
+// validateStatusClasses fails unless every class is one of 1 to 5.
+func validateStatusClasses(classes []int) error {
+	for _, class := range classes {
+		if class < 1 || class > 5 {
+			return invalidf("Invalid status class %d, expected 1 to 5", class)
+		}
 	}
+	return nil
 }
 
-func (r *Request) cook() *http.Request {
+func (r *Request) cook() (*http.Request, error) {
 	req, err := http.NewRequest(r.HTTPMethod,
 		r.URL.String(),
 		createBody(r.PostBody))
 
 	if err != nil {
-		makePanic("Unable to create request: %v", err)
+		return nil, failedf("Unable to create request: %v", err)
 	}
 
 	for _, h := range r.HTTPHeaders {
@@ -192,7 +943,157 @@ func (r *Request) cook() *http.Request {
 		}
 		req.Header.Add(k, v)
 	}
-	return req
+
+	if r.HostHeader != "" {
+		req.Host = r.HostHeader
+	}
+	switch {
+	case r.BasicAuth != "":
+		user, password, _ := strings.Cut(r.BasicAuth, ":")
+		req.SetBasicAuth(user, password)
+	case r.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+r.BearerToken)
+	}
+	if r.Cookies != "" && req.Header.Get("Cookie") == "" {
+		req.Header.Set("Cookie", r.Cookies)
+	}
+	if r.Referer != "" && req.Header.Get("Referer") == "" {
+		req.Header.Set("Referer", r.Referer)
+	}
+	if r.Origin != "" && req.Header.Get("Origin") == "" {
+		req.Header.Set("Origin", r.Origin)
+	}
+	if r.SSE && req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "text/event-stream")
+	}
+	if wantsCompression(req) {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	return req, nil
+}
+
+// checkExpiry reports when the certificate of the final hop expires,
+// warning or failing when that is within the thresholds of r.
+func (r *Request) checkExpiry(w *Response) error {
+	info := w.TLS
+	if info.NotAfter.IsZero() {
+		return nil
+	}
+
+	expiry := fmt.Sprintf("%s (%d days)", info.NotAfter.Format("2006-01-02"), info.DaysRemaining)
+	w.report("Certificate expires: %s", expiry)
+
+	switch {
+	case r.ExpiryFailDays > 0 && info.DaysRemaining < r.ExpiryFailDays:
+		return failedf("Certificate expires %s, within %d days", expiry, r.ExpiryFailDays)
+	case r.ExpiryWarnDays > 0 && info.DaysRemaining < r.ExpiryWarnDays:
+		w.warn(WarnCertExpiring, "certificate expires within %d days", r.ExpiryWarnDays)
+	}
+
+	// the rest of the chain fails verification just the same once it
+	// expires, though renewing the leaf does not renew it
+	if r.ExpiryWarnDays <= 0 || len(info.Chain) < 2 {
+		return nil
+	}
+	for _, c := range info.Chain[1:] {
+		if c.DaysRemaining < r.ExpiryWarnDays && !c.SelfSigned {
+			w.warn(WarnCertExpiring, "intermediate certificate %s expires within %d days", c.Subject, r.ExpiryWarnDays)
+		}
+	}
+	return nil
+}
+
+// withDeadline bounds ctx by the Timeout or Deadline of r, whichever
+// comes first, and describes the bound that applies.
+func (r *Request) withDeadline(ctx context.Context) (context.Context, context.CancelFunc, string) {
+	var deadline time.Time
+	var bound string
+
+	if r.Timeout > 0 {
+		deadline = time.Now().Add(r.Timeout)
+		bound = fmt.Sprintf("timeout of %s", r.Timeout)
+	}
+	if !r.Deadline.IsZero() && (deadline.IsZero() || r.Deadline.Before(deadline)) {
+		deadline = r.Deadline
+		bound = fmt.Sprintf("deadline of %s", r.Deadline.Format(time.RFC3339))
+	}
+
+	if deadline.IsZero() {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, cancel, ""
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return ctx, cancel, bound
+}
+
+// lookupCNAME reports the CNAME records the URL host goes through
+// before resolving to an address.
+func (r *Request) lookupCNAME(ctx context.Context, w *Response) {
+	host := r.URL.Hostname()
+	if net.ParseIP(host) != nil {
+		return
+	}
+
+	answers, err := queryDNS(ctx, r.DNSServer, host, dnsTypeA)
+	if err != nil {
+		w.report("CNAME lookup failed: %v", err)
+		return
+	}
+
+	w.CNAMEs = cnameChain(host, answers)
+	if len(w.CNAMEs) == 0 {
+		w.report("CNAME chain: none, %s resolves directly\n", host)
+		return
+	}
+	w.report("CNAME chain: %s\n", strings.Join(append([]string{host}, w.CNAMEs...), " -> "))
+}
+
+// serverName returns the name to present as SNI, empty to use the URL
+// host. A Host passed in HTTPHeaders doubles as SNI, HostHeader does not.
+func (r *Request) serverName() string {
+	var name string
+	for _, h := range r.HTTPHeaders {
+		k, v := headerKeyValue(h)
+		if strings.EqualFold(k, "host") {
+			name = v
+		}
+	}
+
+	host, _, err := net.SplitHostPort(name)
+	if err != nil {
+		return name
+	}
+	return host
+}
+
+// dropCredentials keeps r from sending what was meant for another host:
+// its BasicAuth, BearerToken, Cookies and HostHeader, and the Authorization, Cookie and Host
+// lines of HTTPHeaders, a Host doubling as SNI.
+func (r *Request) dropCredentials() {
+	r.BasicAuth, r.BearerToken = "", ""
+	r.Cookies = ""
+	r.HostHeader = ""
+	var headers Headers
+	for _, h := range r.HTTPHeaders {
+		switch k, _ := headerKeyValue(h); strings.ToLower(k) {
+		case "authorization", "cookie", "host":
+		default:
+			headers = append(headers, h)
+		}
+	}
+	r.HTTPHeaders = headers
+}
+
+// origin returns the Origin the request is sent with, that of an Origin
+// passed in HTTPHeaders over Origin.
+func (r *Request) origin() string {
+	origin := r.Origin
+	for _, h := range r.HTTPHeaders {
+		if k, v := headerKeyValue(h); strings.EqualFold(k, "origin") {
+			origin = v
+		}
+	}
+	return origin
 }
 
 func createBody(body string) io.Reader {
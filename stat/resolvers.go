@@ -0,0 +1,187 @@
+package stat
+
+import (
+	stdcontext "context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// maxResolvers bounds Request.Resolvers.
+const maxResolvers = 8
+
+// ResolverResult is the answer of one of the resolvers queried in
+// parallel.
+type ResolverResult struct {
+	Server   string        `json:"server"`
+	Duration time.Duration `json:"duration"`
+	Addrs    []string      `json:"addrs,omitempty"`
+	Err      string        `json:"error,omitempty"`
+
+	// Used is set on the fastest successful answer, the one the
+	// request went on with.
+	Used bool `json:"used"`
+}
+
+func (r ResolverResult) String() string {
+	if r.Err != "" {
+		return fmt.Sprintf("%-22s %s failed: %s", r.Server, fmtms(r.Duration), r.Err)
+	}
+	mark := ""
+	if r.Used {
+		mark = " (used)"
+	}
+	return fmt.Sprintf("%-22s %s %s%s", r.Server, fmtms(r.Duration), strings.Join(r.Addrs, ", "), mark)
+}
+
+// validateResolvers checks every resolver is an IP address, with or
+// without a port; a resolver named by host would need resolving itself.
+func validateResolvers(resolvers []string) error {
+	if len(resolvers) > maxResolvers {
+		return invalidf("At most %d resolvers may be queried, got %d", maxResolvers, len(resolvers))
+	}
+	for _, s := range resolvers {
+		if _, err := resolverAddr(s); err != nil {
+			return invalidf("Invalid resolver %q: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// resolverAddr returns s as "ip:port", port 53 unless given.
+func resolverAddr(s string) (string, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		return net.JoinHostPort(s, "53"), nil
+	}
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return "", err
+	}
+	if net.ParseIP(host) == nil {
+		return "", fmt.Errorf("%q is not an IP address", host)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// resolveParallel queries host on every resolver at once. It returns as
+// soon as one of them answers with an address, or all of them failed;
+// collect waits for the remaining answers and returns all of them in
+// the order of resolvers.
+func resolveParallel(ctx context.Context, resolvers []string, host string, qtype uint16) (fastest *ResolverResult, collect func() []ResolverResult) {
+	type answer struct {
+		i int
+		ResolverResult
+	}
+
+	ch := make(chan answer, len(resolvers))
+	start := time.Now()
+	for i, s := range resolvers {
+		go func(i int, s string) {
+			res := ResolverResult{Server: s}
+			addr, _ := resolverAddr(s)
+			records, err := queryDNS(ctx, addr, host, qtype)
+			res.Duration = time.Since(start)
+			for _, rr := range records {
+				if rr.Type == qtype {
+					res.Addrs = append(res.Addrs, rr.Data)
+				}
+			}
+			switch {
+			case err != nil:
+				res.Err = err.Error()
+			case len(res.Addrs) == 0:
+				res.Err = "no addresses"
+			}
+			ch <- answer{i, res}
+		}(i, s)
+	}
+
+	results := make([]ResolverResult, len(resolvers))
+	received := 0
+	for received < len(resolvers) && fastest == nil {
+		a := <-ch
+		received++
+		if a.Err == "" {
+			a.Used = true
+			fastest = &a.ResolverResult
+		}
+		results[a.i] = a.ResolverResult
+	}
+
+	collect = func() []ResolverResult {
+		for ; received < len(resolvers); received++ {
+			a := <-ch
+			results[a.i] = a.ResolverResult
+		}
+		return results
+	}
+	return fastest, collect
+}
+
+// resolversDisagree reports whether the resolvers that answered did not
+// all return the same set of addresses.
+func resolversDisagree(results []ResolverResult) bool {
+	var first string
+	for _, r := range results {
+		if r.Err != "" {
+			continue
+		}
+		addrs := append([]string(nil), r.Addrs...)
+		sort.Strings(addrs)
+		set := strings.Join(addrs, ",")
+		if first == "" {
+			first = set
+		} else if set != first {
+			return true
+		}
+	}
+	return false
+}
+
+// pinnedAddr is an address resolved ahead of the dial.
+type pinnedAddr struct {
+	host, ip string
+}
+
+type pinnedAddrKey struct{}
+
+// withPinnedAddr makes dials of the trace in ctx connect to ip instead
+// of resolving host.
+func withPinnedAddr(ctx context.Context, host, ip string) context.Context {
+	return context.WithValue(ctx, pinnedAddrKey{}, pinnedAddr{host, ip})
+}
+
+// pinAddr rewrites addr to the address pinned in ctx, if any. Dials to
+// other hosts, a proxy for one, are left alone.
+func pinAddr(ctx stdcontext.Context, addr string) string {
+	pin, ok := ctx.Value(pinnedAddrKey{}).(pinnedAddr)
+	if !ok {
+		return addr
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || !strings.EqualFold(host, pin.host) {
+		return addr
+	}
+	return net.JoinHostPort(pin.ip, port)
+}
+
+// reportResolvers records the answers of the parallel resolvers on w.
+func reportResolvers(w *Response, results []ResolverResult) {
+	w.Resolvers = results
+
+	used := false
+	for _, r := range results {
+		w.report("Resolver %s", r)
+		used = used || r.Used
+	}
+	if !used {
+		w.warn(WarnResolversFailed, "all %d resolvers failed, fell back to the system resolver", len(results))
+	}
+	if resolversDisagree(results) {
+		w.warn(WarnResolversDisagree, "resolvers returned different addresses")
+	}
+}
@@ -2,18 +2,221 @@ package stat
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 )
 
 type Response struct {
 	Log []string
 
+	// status code, protocol, headers and phase timings of the final
+	// hop; Hops has those of every hop
+	StatusCode  int
+	Proto       string
+	Header      http.Header
+	Timings     Timings
+	Percentages Percentages
+
+	// every request made, redirects included, in order
+	Hops []Hop
+
+	// request line sent on the final hop, e.g. "GET /path?x=1 HTTP/1.1"
+	RequestLine string
+
+	// whether the final hop went over a pooled connection
+	Reused bool
+
+	// local address of the connection of the final hop, which tells
+	// connections apart
+	LocalAddr string
+
+	// race between IPv6 and IPv4 for the connection of the final hop,
+	// when requested and a connection was made
+	HappyEyeballs *HappyEyeballs
+
+	// value Request.BodyJSONPath found in the final body
+	BodyJSON *JSONPathResult
+
+	// sub-resources the final response declares, with their traces
+	// when requested
+	Subresources []Subresource
+
+	// alternative services the final response advertises, HTTP/3 over
+	// QUIC for one
+	AltSvc []AltService
+
+	// stream of the final hop and the streams sharing its connection,
+	// when requested and HTTP/2 was used
+	HTTP2 *HTTP2Info
+
+	// CNAME chain of the last host visited, host excluded
+	CNAMEs []string
+
+	// records the last host visited resolves through, with their TTL,
+	// when requested
+	DNSRecords []DNSRecord
+
+	// redirects followed, in order
+	Redirects []Redirect
+
+	// runs through the redirect chain that failed before this one, see
+	// Request.Retries
+	Attempts []ChainAttempt
+
+	// URL the trace ended up at after redirects, normalized the way
+	// a browser's address bar shows it
+	FinalURL string
+
+	// set when the trace stopped at the status line of the final
+	// response, see Request.AbortOnStatusClass
+	Aborted bool
+
+	// body of the final response, only set when requested
+	Body *Body
+
+	// set when a SOCKS5 proxy resolved the host, the DNS lookup is
+	// then part of the connection timing
+	RemoteDNS bool
+
+	// answers of the resolvers queried in parallel for the final hop
+	Resolvers []ResolverResult
+
+	// how the request body of the final hop was sent, if there was one
+	Upload *Upload
+
+	// size of the parts of the final response
+	Sizes *Sizes
+
+	// how much a gzip or deflate coding shrank the final response body
+	Compression *Compression
+
+	// declared and sniffed type of the final response body
+	ContentType *ContentType
+
+	// chunk timings of the final response body, only set when requested
+	Stream *Stream
+
+	// server-sent events read from the final response, only set when
+	// requested
+	Events *EventStream
+
+	// TLS session of the final hop, nil over plain HTTP
+	TLS *TLSInfo
+
+	// verdict on the TLS requirements of the final hop, if any
+	TLSCompliance *TLSCompliance
+
+	// connection persistence announced by the final response
+	KeepAlive *KeepAlive
+
+	// Content-Security-Policy of the final response, when requested
+	CSP *CSP
+
+	// cache key components of the final response, when requested
+	Cache *CacheInfo
+
+	// CORS preflight sent before the request, when asked for
+	Preflight *Preflight
+
+	// CORS check of the final response, when the request carried an
+	// Origin
+	CORS *CORS
+
+	// outcome of Request.Assertions, in order
+	Assertions []AssertionResult
+
+	// how far the server clock is ahead of ours, only set when
+	// requested and the response has a Date header
+	ClockSkew *time.Duration
+
+	// whether the final connection used TCP Fast Open, when requested:
+	// "used", "not used", "unavailable" or "not applicable"
+	FastOpen string
+
+	// diagnostics raised along the way, in order
+	Warnings []Warning
+
+	// kernel statistics of the final connection, only set when requested
+	TCPInfo *TCPInfo
+
+	// suspicious constructs in the raw headers of the final response,
+	// only set when requested
+	HeaderAnomalies []HeaderAnomaly
+
+	// comparison with the same request sent to Request.Shadow
+	Shadow *Shadow
+
 	// number of redirects followed
 	redirectsFollowed int
+
+	// method and URL of the final hop
+	method, url string
+
+	// hex SHA-256 of the final response body, empty when unread
+	bodySum string
+}
+
+// Timings holds how long each phase of a request took, in JSON as
+// nanoseconds. Over HTTP/3, TCPConnection is zero and TLSHandshake is
+// the QUIC handshake, which sets up the connection and TLS at once.
+type Timings struct {
+	DNSLookup        time.Duration `json:"dns_lookup"`
+	TCPConnection    time.Duration `json:"tcp_connection"`
+	TLSHandshake     time.Duration `json:"tls_handshake"`
+	ServerProcessing time.Duration `json:"server_processing"`
+	ContentTransfer  time.Duration `json:"content_transfer"`
+	Total            time.Duration `json:"total"`
+}
+
+// Percentages holds the share of each phase of Timings in the total
+// time, from 0 to 100.
+type Percentages struct {
+	DNSLookup        float64 `json:"dns_lookup"`
+	TCPConnection    float64 `json:"tcp_connection"`
+	TLSHandshake     float64 `json:"tls_handshake"`
+	ServerProcessing float64 `json:"server_processing"`
+	ContentTransfer  float64 `json:"content_transfer"`
+}
+
+// Percentages returns the share of each phase in t.Total, all zero when
+// the total is.
+func (t Timings) Percentages() Percentages {
+	return Percentages{
+		DNSLookup:        percentOf(t.DNSLookup, t.Total),
+		TCPConnection:    percentOf(t.TCPConnection, t.Total),
+		TLSHandshake:     percentOf(t.TLSHandshake, t.Total),
+		ServerProcessing: percentOf(t.ServerProcessing, t.Total),
+		ContentTransfer:  percentOf(t.ContentTransfer, t.Total),
+	}
+}
+
+func percentOf(d, total time.Duration) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return 100 * float64(d) / float64(total)
+}
+
+// Hop is when and how long a single request of a trace took.
+type Hop struct {
+	Start      time.Time `json:"start"`
+	Method     string    `json:"method"`
+	URL        string    `json:"url"`
+	StatusCode int       `json:"status_code"`
+	Proto      string    `json:"proto"`
+	Timings    Timings   `json:"timings"`
 }
 
 func (r Response) String() string {
-	return strings.Join(r.Log, "\n")
+	s := strings.Join(r.Log, "\n")
+	if len(r.Warnings) > 0 {
+		s += "\n\nWarnings:"
+		for _, w := range r.Warnings {
+			s += "\n  " + w.String()
+		}
+	}
+	return s
 }
 
 func (r *Response) report(format string, argv ...interface{}) {
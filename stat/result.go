@@ -0,0 +1,89 @@
+package stat
+
+import (
+	"net/http"
+	"time"
+)
+
+// TraceResult is the outcome of a trace in a stable shape for dashboards
+// and scripts, its timings in milliseconds rather than the nanoseconds
+// of the durations of Response. It leaves out the report and the
+// results of optional checks, which Response holds.
+type TraceResult struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Proto      string      `json:"proto"`
+	Header     http.Header `json:"headers"`
+	Reused     bool        `json:"reused"`
+
+	// timings of the final hop
+	Timings MillisecondTimings `json:"timings"`
+
+	// every request made, redirects included, in order
+	Hops []HopResult `json:"hops"`
+
+	Warnings []Warning `json:"warnings"`
+}
+
+// HopResult is one request of a TraceResult.
+type HopResult struct {
+	Start      time.Time          `json:"start"`
+	Method     string             `json:"method"`
+	URL        string             `json:"url"`
+	StatusCode int                `json:"status_code"`
+	Proto      string             `json:"proto"`
+	Timings    MillisecondTimings `json:"timings"`
+}
+
+// MillisecondTimings are Timings in fractional milliseconds.
+type MillisecondTimings struct {
+	DNSLookup        float64 `json:"dns_lookup_ms"`
+	TCPConnection    float64 `json:"tcp_connection_ms"`
+	TLSHandshake     float64 `json:"tls_handshake_ms"`
+	ServerProcessing float64 `json:"server_processing_ms"`
+	ContentTransfer  float64 `json:"content_transfer_ms"`
+	Total            float64 `json:"total_ms"`
+}
+
+// Milliseconds returns t in milliseconds.
+func (t Timings) Milliseconds() MillisecondTimings {
+	ms := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+	return MillisecondTimings{
+		DNSLookup:        ms(t.DNSLookup),
+		TCPConnection:    ms(t.TCPConnection),
+		TLSHandshake:     ms(t.TLSHandshake),
+		ServerProcessing: ms(t.ServerProcessing),
+		ContentTransfer:  ms(t.ContentTransfer),
+		Total:            ms(t.Total),
+	}
+}
+
+// Result returns the outcome of the trace as a TraceResult. Slices are
+// never nil, so that they marshal as empty arrays.
+func (r *Response) Result() *TraceResult {
+	res := &TraceResult{
+		Method:     r.method,
+		URL:        r.url,
+		StatusCode: r.StatusCode,
+		Proto:      r.Proto,
+		Header:     r.Header,
+		Reused:     r.Reused,
+		Timings:    r.Timings.Milliseconds(),
+		Hops:       make([]HopResult, 0, len(r.Hops)),
+		Warnings:   append([]Warning{}, r.Warnings...),
+	}
+	for _, h := range r.Hops {
+		res.Hops = append(res.Hops, HopResult{
+			Start:      h.Start,
+			Method:     h.Method,
+			URL:        h.URL,
+			StatusCode: h.StatusCode,
+			Proto:      h.Proto,
+			Timings:    h.Timings.Milliseconds(),
+		})
+	}
+	return res
+}
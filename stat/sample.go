@@ -0,0 +1,122 @@
+package stat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Samples holds repeated traces of the same request.
+type Samples struct {
+	// Requested is how many samples were asked for; Responses may hold
+	// fewer when TotalBudget ran out.
+	Requested int
+	Responses []*Response
+	Errs      []error
+
+	// BudgetExceeded is set when sampling stopped on TotalBudget.
+	BudgetExceeded bool
+}
+
+// Sample traces r r.Samples times, one after the other, for at most
+// r.TotalBudget overall. Each sample is still bounded by the Timeout
+// and Deadline of r.
+func (t *Tracer) Sample(ctx context.Context, r *Request) *Samples {
+	n := r.Samples
+	if n < 1 {
+		n = 1
+	}
+	s := &Samples{Requested: n}
+
+	if r.TotalBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.TotalBudget)
+		defer cancel()
+	}
+
+	for i := 0; i < n; i++ {
+		if ctx.Err() != nil {
+			s.BudgetExceeded = r.TotalBudget > 0
+			break
+		}
+
+		resp, err := t.Trace(ctx, r)
+		if err != nil && r.TotalBudget > 0 && ctx.Err() != nil {
+			// cut short by the budget, it does not count
+			s.BudgetExceeded = true
+			break
+		}
+		if err != nil {
+			s.Errs = append(s.Errs, err)
+			continue
+		}
+		s.Responses = append(s.Responses, resp)
+	}
+	return s
+}
+
+// Completed returns the number of samples that ran to completion,
+// failed ones included.
+func (s Samples) Completed() int {
+	return len(s.Responses) + len(s.Errs)
+}
+
+// Aggregate returns the per phase minimum, median and maximum of the
+// successful samples.
+func (s Samples) Aggregate() (min, median, max Timings) {
+	if len(s.Responses) == 0 {
+		return
+	}
+
+	var phase [6][]time.Duration
+	for _, resp := range s.Responses {
+		for i, p := range phases(resp.Timings) {
+			phase[i] = append(phase[i], p.D)
+		}
+	}
+
+	stats := make([][3]time.Duration, len(phase))
+	for i, d := range phase {
+		sort.Sort(durations(d))
+		stats[i] = [3]time.Duration{d[0], d[len(d)/2], d[len(d)-1]}
+	}
+
+	timings := func(j int) Timings {
+		return Timings{
+			DNSLookup:        stats[0][j],
+			TCPConnection:    stats[1][j],
+			TLSHandshake:     stats[2][j],
+			ServerProcessing: stats[3][j],
+			ContentTransfer:  stats[4][j],
+			Total:            stats[5][j],
+		}
+	}
+	return timings(0), timings(1), timings(2)
+}
+
+func (s Samples) String() string {
+	o := []string{fmt.Sprintf("Samples: %d of %d completed, %d failed",
+		s.Completed(), s.Requested, len(s.Errs))}
+	if s.BudgetExceeded {
+		o[0] += ", stopped by the time budget"
+	}
+
+	if len(s.Responses) == 0 {
+		return o[0]
+	}
+	if len(s.Responses) < s.Requested {
+		o = append(o, fmt.Sprintf("Based on %d successful samples out of %d requested",
+			len(s.Responses), s.Requested))
+	}
+
+	min, median, max := s.Aggregate()
+	a, b, c := phases(min), phases(median), phases(max)
+	for i := range a {
+		o = append(o, fmt.Sprintf("%s: min %s, median %s, max %s",
+			a[i].Name, fmtms(a[i].D), fmtms(b[i].D), fmtms(c[i].D)))
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,253 @@
+package stat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultSessionIdle is how long a session stays open without a
+	// trace when no idle timeout is given, maxSessionIdle the longest
+	// allowed: the transport drops idle connections after that anyway.
+	DefaultSessionIdle = 30 * time.Second
+	maxSessionIdle     = 90 * time.Second
+)
+
+// A Session traces requests to a host over a connection kept open
+// between them, to observe how the server handles several requests on
+// one connection: whether it keeps it open, for how long, and how fast
+// later requests are. Requests are sent one after the other, never
+// pipelined.
+//
+// A session closes, along with its connection, when it goes unused for
+// its idle timeout.
+type Session struct {
+	ID string
+
+	tracer *Tracer
+	base   Request
+	idle   time.Duration
+
+	// turn lets one trace through at a time, mu guards the rest and is
+	// not held over a trace so that Close never waits for one
+	turn sync.Mutex
+
+	mu       sync.Mutex
+	timer    *time.Timer
+	closed   bool
+	cancel   context.CancelFunc // of the trace under way
+	conn     string             // local address of the connection in use
+	requests int
+	conns    int
+	onClose  func()
+}
+
+// SessionTurn tells how a request of a session went over the
+// connection.
+type SessionTurn struct {
+	// Request counts the requests of the session, Connection the
+	// connections it went through, from 1.
+	Request    int `json:"request"`
+	Connection int `json:"connection"`
+
+	// Reused is set when the request went over the connection of the
+	// previous one. When it did not, the server, or a proxy, closed
+	// that connection in between.
+	Reused bool `json:"reused"`
+}
+
+func (t SessionTurn) String() string {
+	switch {
+	case t.Request == 1:
+		return "request 1, connection opened"
+	case t.Reused:
+		return fmt.Sprintf("request %d, connection %d reused", t.Request, t.Connection)
+	default:
+		return fmt.Sprintf("request %d, connection %d opened, the previous one was closed", t.Request, t.Connection)
+	}
+}
+
+// newSession opens a session to the host of r with a tracer of its own,
+// so that no other trace takes its connection, and traces r. Redirects
+// are not followed, they may lead to another host.
+func newSession(ctx context.Context, r *Request, idle time.Duration) (*Session, *Response, error) {
+	if idle <= 0 {
+		idle = DefaultSessionIdle
+	}
+	if idle > maxSessionIdle {
+		return nil, nil, fmt.Errorf("Session idle timeout must be %s at most", maxSessionIdle)
+	}
+
+	s := &Session{
+		ID:     randomID(),
+		tracer: NewTracer(),
+		base:   *r,
+		idle:   idle,
+	}
+	s.base.FollowRedirects = false
+
+	resp, _, err := s.Trace(ctx, r.URL.RequestURI())
+	if err != nil {
+		s.Close()
+		return nil, nil, err
+	}
+	return s, resp, nil
+}
+
+// Trace traces path, resolved against the URL the session was opened
+// with, over the connection of the session if the server kept it open.
+// Concurrent calls are traced one after the other. Closing the session
+// aborts the trace under way.
+func (s *Session) Trace(ctx context.Context, path string) (*Response, SessionTurn, error) {
+	s.turn.Lock()
+	defer s.turn.Unlock()
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, SessionTurn{}, errors.New("Session closed")
+	}
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	defer func() {
+		cancel()
+		s.mu.Lock()
+		s.cancel = nil
+		if !s.closed {
+			s.timer = time.AfterFunc(s.idle, s.Close)
+		}
+		s.mu.Unlock()
+	}()
+
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, SessionTurn{}, fmt.Errorf("Invalid path %q: %v", path, err)
+	}
+	req := s.base
+	req.URL = s.base.URL.ResolveReference(ref)
+	if req.URL.Scheme != s.base.URL.Scheme || req.URL.Host != s.base.URL.Host {
+		return nil, SessionTurn{}, fmt.Errorf("Path %q leaves %s://%s, the host of the session", path, s.base.URL.Scheme, s.base.URL.Host)
+	}
+
+	resp, err := s.tracer.Trace(ctx, &req)
+	if err != nil {
+		return nil, SessionTurn{}, err
+	}
+
+	s.mu.Lock()
+	s.requests++
+	turn := SessionTurn{
+		Request: s.requests,
+		Reused:  resp.Reused && resp.LocalAddr == s.conn,
+	}
+	if !turn.Reused {
+		s.conns++
+		s.conn = resp.LocalAddr
+	}
+	turn.Connection = s.conns
+	s.mu.Unlock()
+	resp.report("Session: %s", turn)
+	return resp, turn, nil
+}
+
+// Close closes the session and its connection, aborting the trace under
+// way. It is safe to call several times.
+func (s *Session) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.tracer.CloseIdleConnections()
+	onClose := s.onClose
+	s.mu.Unlock()
+
+	// outside the lock, Sessions locks itself first
+	if onClose != nil {
+		onClose()
+	}
+}
+
+// randomID returns an ID not worth guessing, for the sessions and
+// monitors handed out to clients.
+func randomID() string {
+	var id [16]byte
+	rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// Sessions keeps the open sessions by ID, up to a maximum, forgetting
+// them as they close.
+//
+// Sessions is safe for concurrent use.
+type Sessions struct {
+	max int
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+func NewSessions(max int) *Sessions {
+	return &Sessions{max: max, sessions: make(map[string]*Session)}
+}
+
+// Open opens a session with r, see Session, closing after idle without
+// a trace, DefaultSessionIdle when zero. It returns the trace of r.
+func (ss *Sessions) Open(ctx context.Context, r *Request, idle time.Duration) (*Session, *Response, error) {
+	ss.mu.Lock()
+	full := len(ss.sessions) >= ss.max
+	ss.mu.Unlock()
+	if full {
+		return nil, nil, fmt.Errorf("Too many sessions open, %d at most", ss.max)
+	}
+
+	s, resp, err := newSession(ctx, r, idle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if len(ss.sessions) >= ss.max {
+		s.Close()
+		return nil, nil, fmt.Errorf("Too many sessions open, %d at most", ss.max)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, nil, errors.New("Session closed")
+	}
+	ss.sessions[s.ID] = s
+	s.onClose = func() {
+		ss.mu.Lock()
+		delete(ss.sessions, s.ID)
+		ss.mu.Unlock()
+	}
+	return s, resp, nil
+}
+
+// Get returns the open session id, nil if there is none.
+func (ss *Sessions) Get(id string) *Session {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.sessions[id]
+}
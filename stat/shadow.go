@@ -0,0 +1,124 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Shadow is the outcome of sending a request to Request.Shadow as well,
+// compared with the traced response.
+type Shadow struct {
+	URL        string  `json:"url"`
+	StatusCode int     `json:"status_code"`
+	Timings    Timings `json:"timings"`
+
+	// Err is why the shadow request failed, nothing is compared then.
+	Err string `json:"error,omitempty"`
+
+	StatusMatched  bool `json:"status_matched"`
+	HeadersMatched bool `json:"headers_matched"`
+	BodyMatched    bool `json:"body_matched"`
+
+	// hex SHA-256 of both bodies, empty when unread
+	BodySHA256        string `json:"body_sha256"`
+	PrimaryBodySHA256 string `json:"primary_body_sha256"`
+
+	// Mismatches describes what differs, in order.
+	Mismatches []string `json:"mismatches"`
+
+	// Response is the full trace of the shadow request.
+	Response *Response `json:"-"`
+
+	primaryTotal time.Duration
+}
+
+// Matched reports whether status, headers and body all matched.
+func (s *Shadow) Matched() bool {
+	return s.Err == "" && s.StatusMatched && s.HeadersMatched && s.BodyMatched
+}
+
+func (s *Shadow) String() string {
+	if s.Err != "" {
+		return fmt.Sprintf("Shadow %s: failed: %s", s.URL, s.Err)
+	}
+	o := fmt.Sprintf("Shadow %s: %d in %s (%s)", s.URL, s.StatusCode,
+		fmtms(s.Timings.Total), fmtDiff(s.Timings.Total-s.primaryTotal))
+	if s.Matched() {
+		return o + ", matched"
+	}
+	return o + ", mismatched: " + strings.Join(s.Mismatches, ", ")
+}
+
+// validateShadow fails when r.Shadow is not a URL to send to.
+func validateShadow(r *Request) error {
+	u, err := parseURL(r.Shadow)
+	if err != nil {
+		return err
+	}
+	if u.Host == "" {
+		return invalidf("Invalid shadow URL %q: no host", r.Shadow)
+	}
+	return nil
+}
+
+// shadow traces r against r.Shadow in the background. The trace is
+// bound to ctx, so that it does not outlive the primary one.
+func (t *Tracer) shadow(ctx context.Context, r *Request) <-chan *Shadow {
+	req := *r
+	req.URL, _ = parseURL(r.Shadow) // checked by validateShadow
+	req.Shadow = ""
+	req.Assertions = nil
+
+	ch := make(chan *Shadow, 1)
+	go func() {
+		s := &Shadow{URL: req.URL.String()}
+		resp, err := t.Trace(ctx, &req)
+		if err != nil {
+			s.Err = err.Error()
+		} else {
+			s.Response = resp
+			s.StatusCode = resp.StatusCode
+			s.Timings = resp.Timings
+			s.BodySHA256 = resp.bodySum
+		}
+		ch <- s
+	}()
+	return ch
+}
+
+// compare fills in how s differs from primary, comparing headers by
+// their canonical names.
+func (s *Shadow) compare(primary *Response, headers []string) *Shadow {
+	s.primaryTotal = primary.Timings.Total
+	s.PrimaryBodySHA256 = primary.bodySum
+	if s.Err != "" {
+		return s
+	}
+
+	s.StatusMatched = s.StatusCode == primary.StatusCode
+	if !s.StatusMatched {
+		s.Mismatches = append(s.Mismatches, fmt.Sprintf("status %d vs %d", primary.StatusCode, s.StatusCode))
+	}
+
+	if headers == nil {
+		headers = []string{"Content-Type"}
+	}
+	s.HeadersMatched = true
+	for _, h := range headers {
+		a, b := primary.Header.Get(h), s.Response.Header.Get(h)
+		if a != b {
+			s.HeadersMatched = false
+			s.Mismatches = append(s.Mismatches, fmt.Sprintf("header %s %q vs %q", http.CanonicalHeaderKey(h), a, b))
+		}
+	}
+
+	s.BodyMatched = s.BodySHA256 == s.PrimaryBodySHA256
+	if !s.BodyMatched {
+		s.Mismatches = append(s.Mismatches, "body")
+	}
+	return s
+}
@@ -0,0 +1,491 @@
+package stat
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Limits of Tracer.Sitemap.
+const (
+	defaultSitemapURLs        = 100
+	defaultSitemapConcurrency = 4
+	maxSitemapConcurrency     = 32
+
+	// sitemaps may hold 50MB uncompressed, index files may list
+	// sitemaps which, in practice, are not indexes again
+	maxSitemapBytes = 50 << 20
+	maxSitemapDepth = 3
+
+	// bounds of the walk through the sitemaps as a whole, before any
+	// page is traced
+	maxSitemapFetches    = 50
+	maxSitemapTotalBytes = 200 << 20
+	maxSitemapWalk       = 2 * time.Minute
+
+	// how many malformed entries and slowest pages are listed
+	maxSitemapListed = 10
+)
+
+// Site holds the traces of the URLs listed in a sitemap.
+type Site struct {
+	// Sitemaps are the sitemaps fetched, index files included, and
+	// SitemapErrors why some of them could not be read, entirely or
+	// in part.
+	Sitemaps      []string `json:"sitemaps"`
+	SitemapErrors []string `json:"sitemap_errors,omitempty"`
+
+	// Malformed counts the entries skipped for lacking an absolute
+	// http or https URL, the first few of which are listed.
+	Malformed        int      `json:"malformed"`
+	MalformedEntries []string `json:"malformed_entries,omitempty"`
+
+	// Truncated is set when the sitemaps listed more than MaxURLs URLs;
+	// the rest were neither collected nor traced.
+	Truncated bool `json:"truncated"`
+
+	// Pages are the traces, in the order of the sitemaps.
+	Pages []SitePage `json:"pages"`
+}
+
+// SitePage is the trace of one URL of a sitemap.
+type SitePage struct {
+	URL        string        `json:"url"`
+	StatusCode int           `json:"status_code,omitempty"`
+	Total      time.Duration `json:"total"`
+	Err        string        `json:"error,omitempty"`
+}
+
+// Failed reports whether the trace failed or the page answered with a
+// client or server error.
+func (p SitePage) Failed() bool {
+	return p.Err != "" || p.StatusCode >= 400
+}
+
+// Sitemap fetches the sitemap at r.URL, following sitemap index files,
+// and traces each of the URLs it lists with the options of r, up to
+// r.MaxURLs of them (100 when zero) and r.MaxConcurrency at once (4
+// when zero, at most 32). Gzipped sitemaps are decompressed. Up to 50
+// sitemaps and 200MB are read, within 2 minutes, before tracing. It
+// fails only when the sitemap at r.URL cannot be fetched.
+func (t *Tracer) Sitemap(ctx context.Context, r *Request) (*Site, error) {
+	if err := t.policy().check(r); err != nil {
+		return nil, err
+	}
+
+	max := r.sitemapURLs()
+	site := &Site{}
+	c := &sitemapCollector{t: t, r: r, site: site, max: max,
+		seen: make(map[string]bool), bytesLeft: maxSitemapTotalBytes}
+	walkCtx, cancel := context.WithTimeout(ctx, maxSitemapWalk)
+	err := c.collect(walkCtx, r.URL, 0)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	n := r.sitemapConcurrency()
+
+	site.Pages = make([]SitePage, len(c.urls))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range next {
+				site.Pages[j] = t.tracePage(ctx, r, c.urls[j])
+			}
+		}()
+	}
+	for j := range c.urls {
+		next <- j
+	}
+	close(next)
+	wg.Wait()
+	return site, nil
+}
+
+func (r *Request) sitemapURLs() int {
+	if r.MaxURLs <= 0 {
+		return defaultSitemapURLs
+	}
+	return r.MaxURLs
+}
+
+func (r *Request) sitemapConcurrency() int {
+	n := r.MaxConcurrency
+	if n <= 0 {
+		n = defaultSitemapConcurrency
+	}
+	if n > maxSitemapConcurrency {
+		n = maxSitemapConcurrency
+	}
+	return n
+}
+
+// SitemapTraces returns how many traces Sitemap runs at once at most
+// for r.
+func (r *Request) SitemapTraces() int {
+	n := r.sitemapConcurrency()
+	if max := r.sitemapURLs(); n > max {
+		n = max
+	}
+	return n * r.Traces()
+}
+
+func (t *Tracer) tracePage(ctx context.Context, r *Request, u *url.URL) SitePage {
+	p := SitePage{URL: u.String()}
+	req := *r
+	req.URL = u
+	resp, err := t.Trace(ctx, &req)
+	if err != nil {
+		p.Err = err.Error()
+		return p
+	}
+	p.StatusCode = resp.StatusCode
+	p.Total = resp.Timings.Total
+	return p
+}
+
+// sitemapCollector gathers the URLs of a sitemap and of those it
+// links to.
+type sitemapCollector struct {
+	t    *Tracer
+	r    *Request
+	site *Site
+	max  int
+
+	seen map[string]bool // sitemaps and pages, to skip repeats
+	urls []*url.URL
+
+	// bytesLeft is what remains to read of maxSitemapTotalBytes, and
+	// stopped is set once the walk hit one of its bounds.
+	bytesLeft int64
+	stopped   bool
+}
+
+// collect adds the URLs listed at u. Failing to fetch u is returned,
+// anything going wrong further down is recorded in the Site.
+func (c *sitemapCollector) collect(ctx context.Context, u *url.URL, depth int) error {
+	c.seen["sitemap "+u.String()] = true
+	c.site.Sitemaps = append(c.site.Sitemaps, u.String())
+
+	body, err := c.fetch(ctx, u)
+	if err != nil {
+		return failf(err, "Unable to fetch sitemap %s: %v", u, err)
+	}
+	defer body.Close()
+
+	locs, err := sitemapLocs(&sitemapBudget{Reader: body, left: &c.bytesLeft})
+	if err != nil {
+		// keep what was read up to the error
+		c.site.SitemapErrors = append(c.site.SitemapErrors, fmt.Sprintf("%s: %v", u, err))
+	}
+
+	for _, l := range locs {
+		if c.site.Truncated || c.stopped {
+			return nil
+		}
+		loc, ok := sitemapURL(l.loc)
+		if !ok {
+			c.site.Malformed++
+			if len(c.site.MalformedEntries) < maxSitemapListed {
+				c.site.MalformedEntries = append(c.site.MalformedEntries, l.loc)
+			}
+			continue
+		}
+
+		if l.sitemap {
+			key := "sitemap " + loc.String()
+			switch {
+			case c.seen[key]:
+			case depth+1 >= maxSitemapDepth:
+				c.site.SitemapErrors = append(c.site.SitemapErrors,
+					fmt.Sprintf("%s: sitemap indexes nested too deep, skipped", loc))
+			case len(c.site.Sitemaps) >= maxSitemapFetches:
+				c.stop(fmt.Sprintf("more than %d sitemaps, the rest skipped", maxSitemapFetches))
+			case c.bytesLeft <= 0:
+				c.stop(fmt.Sprintf("more than %d bytes of sitemaps, the rest skipped", maxSitemapTotalBytes))
+			case ctx.Err() != nil:
+				c.stop(fmt.Sprintf("sitemaps not read within %s, the rest skipped", maxSitemapWalk))
+			default:
+				if err := c.collect(ctx, loc, depth+1); err != nil {
+					c.site.SitemapErrors = append(c.site.SitemapErrors, err.Error())
+				}
+			}
+			continue
+		}
+
+		key := "page " + loc.String()
+		if c.seen[key] {
+			continue
+		}
+		if len(c.urls) == c.max {
+			c.site.Truncated = true
+			return nil
+		}
+		c.seen[key] = true
+		c.urls = append(c.urls, loc)
+	}
+	return nil
+}
+
+// stop ends the walk, recording why.
+func (c *sitemapCollector) stop(why string) {
+	c.stopped = true
+	c.site.SitemapErrors = append(c.site.SitemapErrors, why)
+}
+
+// sitemapBudget reads on until the bytes left to the walk run out.
+type sitemapBudget struct {
+	io.Reader
+	left *int64
+}
+
+func (b *sitemapBudget) Read(p []byte) (int, error) {
+	if *b.left <= 0 {
+		return 0, fmt.Errorf("more than %d bytes of sitemaps read", maxSitemapTotalBytes)
+	}
+	if int64(len(p)) > *b.left {
+		p = p[:*b.left]
+	}
+	n, err := b.Reader.Read(p)
+	*b.left -= int64(n)
+	return n, err
+}
+
+// fetch returns the body of the sitemap at u, decompressed when it is
+// gzipped whatever its Content-Type says.
+func (c *sitemapCollector) fetch(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req := *c.r
+	req.URL = u
+	ctx, cancel, _ := req.withDeadline(ctx)
+
+	hreq, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	tr, err := c.t.transport(&req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	client := &http.Client{Transport: tr}
+	resp, err := client.Do(hreq.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		cancel()
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	body := &sitemapBody{Closer: resp.Body, cancel: cancel}
+	br := bufio.NewReader(io.LimitReader(resp.Body, maxSitemapBytes))
+	body.Reader = br
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			body.Close()
+			return nil, err
+		}
+		body.Reader = io.LimitReader(zr, maxSitemapBytes)
+	}
+	return body, nil
+}
+
+type sitemapBody struct {
+	io.Reader
+	io.Closer
+	cancel func()
+}
+
+func (b *sitemapBody) Close() error {
+	defer b.cancel()
+	return b.Closer.Close()
+}
+
+// sitemapLoc is a loc element of a sitemap, that of a sitemap when
+// found in a sitemap index.
+type sitemapLoc struct {
+	loc     string
+	sitemap bool
+}
+
+// sitemapLocs returns the loc elements of a sitemap or sitemap index,
+// along with the error that stopped the reading, if any.
+func sitemapLocs(r io.Reader) ([]sitemapLoc, error) {
+	d := xml.NewDecoder(r)
+	d.Strict = false
+
+	var locs []sitemapLoc
+	var parents []string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return locs, nil
+		}
+		if err != nil {
+			return locs, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if tok.Name.Local != "loc" {
+				parents = append(parents, tok.Name.Local)
+				continue
+			}
+			var loc string
+			if err := d.DecodeElement(&loc, &tok); err != nil {
+				return locs, err
+			}
+			if len(parents) == 0 {
+				continue
+			}
+			switch parents[len(parents)-1] {
+			case "url":
+				locs = append(locs, sitemapLoc{strings.TrimSpace(loc), false})
+			case "sitemap":
+				locs = append(locs, sitemapLoc{strings.TrimSpace(loc), true})
+			}
+		case xml.EndElement:
+			if len(parents) > 0 {
+				parents = parents[:len(parents)-1]
+			}
+		}
+	}
+}
+
+// sitemapURL parses loc, which has to be an absolute http or https URL.
+func sitemapURL(loc string) (*url.URL, bool) {
+	u, err := url.Parse(loc)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, false
+	}
+	return u, true
+}
+
+// StatusClasses counts the pages by status class, "2xx" to "5xx", and
+// failed traces as "error".
+func (s Site) StatusClasses() map[string]int {
+	classes := make(map[string]int)
+	for _, p := range s.Pages {
+		if p.Err != "" {
+			classes["error"]++
+			continue
+		}
+		classes[fmt.Sprintf("%dxx", p.StatusCode/100)]++
+	}
+	return classes
+}
+
+// Totals returns the minimum, median and maximum total time of the
+// pages that answered.
+func (s Site) Totals() (min, median, max time.Duration) {
+	var d []time.Duration
+	for _, p := range s.Pages {
+		if p.Err == "" {
+			d = append(d, p.Total)
+		}
+	}
+	if len(d) == 0 {
+		return
+	}
+	sort.Sort(durations(d))
+	return d[0], d[len(d)/2], d[len(d)-1]
+}
+
+// Slowest returns the n pages that took the longest to answer, slowest
+// first.
+func (s Site) Slowest(n int) []SitePage {
+	var pages []SitePage
+	for _, p := range s.Pages {
+		if p.Err == "" {
+			pages = append(pages, p)
+		}
+	}
+	sort.SliceStable(pages, func(i, j int) bool { return pages[i].Total > pages[j].Total })
+	if len(pages) > n {
+		pages = pages[:n]
+	}
+	return pages
+}
+
+// Failing returns the pages that failed, see SitePage.Failed.
+func (s Site) Failing() []SitePage {
+	var pages []SitePage
+	for _, p := range s.Pages {
+		if p.Failed() {
+			pages = append(pages, p)
+		}
+	}
+	return pages
+}
+
+func (s Site) String() string {
+	line := fmt.Sprintf("Sitemap: %d URLs traced from %d sitemaps", len(s.Pages), len(s.Sitemaps))
+	if s.Truncated {
+		line += ", more were listed"
+	}
+	if s.Malformed > 0 {
+		line += fmt.Sprintf(", %d malformed entries skipped", s.Malformed)
+	}
+	o := []string{line}
+
+	failing := s.Failing()
+	if len(s.Pages) > 0 {
+		classes := s.StatusClasses()
+		names := make([]string, 0, len(classes))
+		for c := range classes {
+			names = append(names, c)
+		}
+		sort.Strings(names)
+		counts := make([]string, len(names))
+		for i, c := range names {
+			counts[i] = fmt.Sprintf("%s %d", c, classes[c])
+		}
+		o = append(o, fmt.Sprintf("Statuses: %s, %d failing", strings.Join(counts, ", "), len(failing)))
+	}
+	if min, median, max := s.Totals(); max > 0 {
+		o = append(o, fmt.Sprintf("Total: min %s, median %s, max %s", fmtms(min), fmtms(median), fmtms(max)))
+	}
+
+	if len(failing) > 0 {
+		o = append(o, "Failing:")
+		for _, p := range failing {
+			if p.Err != "" {
+				o = append(o, fmt.Sprintf("  error %s: %s", p.URL, p.Err))
+			} else {
+				o = append(o, fmt.Sprintf("  %d %s", p.StatusCode, p.URL))
+			}
+		}
+	}
+	if slowest := s.Slowest(maxSitemapListed); len(slowest) > 0 {
+		o = append(o, "Slowest:")
+		for _, p := range slowest {
+			o = append(o, fmt.Sprintf("  %s %s", fmtms(p.Total), p.URL))
+		}
+	}
+
+	for _, e := range s.SitemapErrors {
+		o = append(o, "Sitemap error: "+e)
+	}
+	for _, e := range s.MalformedEntries {
+		o = append(o, fmt.Sprintf("Malformed entry: %q", e))
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,58 @@
+package stat
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sizes splits the size of a response in bytes. They are computed from
+// the parsed response, so whitespace a server sent beyond the usual
+// "Name: value" form is not accounted for.
+//
+// HTTP/2 has no status line nor header block on the wire; there the
+// :status pseudo-header and the headers are counted the way
+// SETTINGS_MAX_HEADER_LIST_SIZE does, as the uncompressed length of
+// name and value plus 32 bytes of overhead per field.
+type Sizes struct {
+	StatusLine int64 `json:"status_line"`
+	Headers    int64 `json:"headers"`
+	Body       int64 `json:"body"`
+}
+
+// http2FieldOverhead is the per field overhead of RFC 7540, section 6.5.2.
+const http2FieldOverhead = 32
+
+func newSizes(resp *http.Response, body int64) *Sizes {
+	s := &Sizes{Body: body}
+
+	if resp.ProtoMajor == 2 {
+		s.StatusLine = int64(len(":status") + len(fmt.Sprint(resp.StatusCode)) + http2FieldOverhead)
+		for k, vv := range resp.Header {
+			for _, v := range vv {
+				s.Headers += int64(len(k) + len(v) + http2FieldOverhead)
+			}
+		}
+		return s
+	}
+
+	// "HTTP/1.1 200 OK\r\n"
+	s.StatusLine = int64(len(resp.Proto) + 1 + len(resp.Status) + 2)
+	for k, vv := range resp.Header {
+		for _, v := range vv {
+			// "Name: value\r\n"
+			s.Headers += int64(len(k) + 2 + len(v) + 2)
+		}
+	}
+	// the empty line ending the block
+	s.Headers += 2
+	return s
+}
+
+func (s Sizes) String() string {
+	return strings.Join([]string{
+		fmt.Sprintf("status line %d bytes", s.StatusLine),
+		fmt.Sprintf("headers %d bytes", s.Headers),
+		fmt.Sprintf("body %d bytes", s.Body),
+	}, ", ")
+}
@@ -0,0 +1,32 @@
+package stat
+
+import (
+	"net/http"
+	"time"
+)
+
+// reportClockSkew compares the Date header of a response with our clock.
+// The server stamped the response somewhere between sending the request
+// and receiving the first byte of the response; the midpoint is taken.
+func reportClockSkew(w *Response, h http.Header, sent, received time.Time) {
+	date := h.Get("Date")
+	if date == "" {
+		w.report("Server clock skew: no Date header")
+		return
+	}
+	t, err := http.ParseTime(date)
+	if err != nil {
+		w.report("Server clock skew: invalid Date header %q", date)
+		return
+	}
+
+	mid := sent.Add(received.Sub(sent) / 2)
+	skew := t.Sub(mid).Round(time.Second)
+	w.ClockSkew = &skew
+
+	sign := "+"
+	if skew < 0 {
+		sign = ""
+	}
+	w.report("Server clock skew: %s%s", sign, skew)
+}
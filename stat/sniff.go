@@ -0,0 +1,74 @@
+package stat
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// sniffLen is how much of a body http.DetectContentType looks at.
+const sniffLen = 512
+
+// ContentType compares the Content-Type a server declared with the one
+// detected from the body.
+type ContentType struct {
+	Declared string `json:"declared"`
+	Detected string `json:"detected"`
+
+	// Mismatch is set when the two disagree, or nothing was declared.
+	Mismatch bool `json:"mismatch"`
+}
+
+func sniffContentType(declared string, head []byte) *ContentType {
+	ct := &ContentType{
+		Declared: declared,
+		Detected: http.DetectContentType(head),
+	}
+	ct.Mismatch = !compatibleTypes(mediaType(ct.Declared), mediaType(ct.Detected))
+	return ct
+}
+
+func (ct ContentType) String() string {
+	if ct.Declared == "" {
+		return fmt.Sprintf("no Content-Type declared, body looks like %s", mediaType(ct.Detected))
+	}
+	return fmt.Sprintf("declared Content-Type %s but body looks like %s",
+		mediaType(ct.Declared), mediaType(ct.Detected))
+}
+
+// compatibleTypes reports whether a body declared as declared can
+// reasonably be detected as detected. DetectContentType only knows a
+// handful of types and calls any other text text/plain.
+func compatibleTypes(declared, detected string) bool {
+	switch {
+	case declared == "":
+		return false
+	case declared == detected:
+		return true
+	case detected == "text/plain":
+		return isTextual(declared)
+	case detected == "text/xml":
+		return strings.HasSuffix(declared, "xml")
+	case detected == "application/octet-stream":
+		// binary the sniffer did not recognize
+		return !isTextual(declared)
+	}
+	return false
+}
+
+func isTextual(mt string) bool {
+	return strings.HasPrefix(mt, "text/") ||
+		strings.HasSuffix(mt, "json") ||
+		strings.HasSuffix(mt, "xml") ||
+		strings.HasSuffix(mt, "javascript") ||
+		mt == "application/x-www-form-urlencoded"
+}
+
+func mediaType(v string) string {
+	mt, _, err := mime.ParseMediaType(v)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(v))
+	}
+	return mt
+}
@@ -0,0 +1,107 @@
+package stat
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http/httptrace"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// socksDialer connects through a SOCKS5 proxy, handing it the host name
+// to resolve so that the local resolver never sees it. Only proxies
+// accepting clients without authentication, as Tor does, are supported.
+type socksDialer struct {
+	*net.Dialer
+	proxy   string
+	network string
+}
+
+// DialContext reports the whole proxy path, from connecting to the proxy
+// until it has connected to addr, as the connection phase of the trace.
+// There is no local DNS lookup to report.
+func (d socksDialer) DialContext(ctx context.Context, _, addr string) (net.Conn, error) {
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.DNSStart != nil {
+		host, _, _ := net.SplitHostPort(addr)
+		trace.DNSStart(httptrace.DNSStartInfo{Host: host})
+		trace.DNSDone(httptrace.DNSDoneInfo{})
+	}
+	if trace != nil && trace.ConnectStart != nil {
+		trace.ConnectStart("tcp", addr)
+	}
+
+	conn, err := d.dial(ctx, addr)
+
+	if trace != nil && trace.ConnectDone != nil {
+		trace.ConnectDone("tcp", addr, err)
+	}
+	return conn, err
+}
+
+func (d socksDialer) dial(ctx context.Context, addr string) (net.Conn, error) {
+	// proxy dialers know nothing of contexts, the deadline of ctx bounds
+	// the handshake instead
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(d.Timeout)
+	}
+	forward := &proxyForward{ctx: ctx, dialer: d.Dialer, deadline: deadline}
+
+	// proxy.SOCKS5 sends host names as they are, for the proxy to resolve
+	socks, err := proxy.SOCKS5(d.network, d.proxy, nil, forward)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := socks.Dial("tcp", addr)
+	if forward.stop != nil && !forward.stop() && err == nil {
+		// canceled just as the handshake completed
+		conn.Close()
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("SOCKS5 proxy %s: %w", d.proxy, err)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// proxyForward dials the proxy for proxy.SOCKS5 within ctx, setting
+// deadline on the connection for the handshake that follows, which
+// canceling ctx aborts until stop is called.
+type proxyForward struct {
+	ctx      context.Context
+	dialer   *net.Dialer
+	deadline time.Time
+	stop     func() bool
+}
+
+func (f *proxyForward) Dial(network, addr string) (net.Conn, error) {
+	// the trace hooks are fired by DialContext, not for the dial to the
+	// proxy
+	conn, err := f.dialer.DialContext(untraced{f.ctx}, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(f.deadline)
+	f.stop = context.AfterFunc(f.ctx, func() { conn.SetDeadline(time.Unix(1, 0)) })
+	return conn, nil
+}
+
+// untraced hides the values of a context, the client trace among them,
+// while keeping its deadline and cancellation.
+type untraced struct {
+	context.Context
+}
+
+func (untraced) Value(interface{}) interface{} { return nil }
+
+// validateSOCKS5 checks the proxy address is "host:port".
+func validateSOCKS5(proxy string) error {
+	if _, port, err := net.SplitHostPort(proxy); err != nil || port == "" {
+		return invalidf("Invalid SOCKS5 proxy %q, expected host:port", proxy)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package stat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"mime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// limits applied to event streams when the Request leaves them unset
+const (
+	defaultSSEMaxEvents   = 5
+	defaultSSEMaxDuration = 10 * time.Second
+)
+
+// maxEventData is how much of the data of an event is kept, and
+// maxReportedEvents how many events are listed in the report.
+const (
+	maxEventData      = 256
+	maxReportedEvents = 3
+)
+
+// EventStream records the server-sent events read from a response.
+type EventStream struct {
+	Events []Event `json:"events"`
+	Bytes  int64   `json:"bytes"`
+
+	// ContentType is set when the response declared text/event-stream.
+	ContentType bool `json:"content_type"`
+
+	// Stopped tells why reading stopped.
+	Stopped string `json:"stopped"`
+}
+
+// Event is a single server-sent event.
+type Event struct {
+	// time since the first response byte
+	Offset time.Duration `json:"offset"`
+
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+
+	// Data is cut to its first 256 bytes.
+	Data string `json:"data"`
+}
+
+// FirstEvent returns how long after the first response byte the first
+// event arrived, zero when none did.
+func (s EventStream) FirstEvent() time.Duration {
+	if len(s.Events) == 0 {
+		return 0
+	}
+	return s.Events[0].Offset
+}
+
+// MedianGap returns the median time between two consecutive events.
+func (s EventStream) MedianGap() time.Duration {
+	if len(s.Events) < 2 {
+		return 0
+	}
+
+	gaps := make([]time.Duration, 0, len(s.Events)-1)
+	for i := 1; i < len(s.Events); i++ {
+		gaps = append(gaps, s.Events[i].Offset-s.Events[i-1].Offset)
+	}
+	sort.Sort(durations(gaps))
+
+	m := len(gaps) / 2
+	if len(gaps)%2 == 0 {
+		return (gaps[m-1] + gaps[m]) / 2
+	}
+	return gaps[m]
+}
+
+func (s EventStream) String() string {
+	if len(s.Events) == 0 {
+		return "no events received (" + s.Stopped + ")"
+	}
+	return fmt.Sprintf("%d events, %d bytes; first event %s, median gap %s (%s)",
+		len(s.Events), s.Bytes, fmtms(s.FirstEvent()), fmtms(s.MedianGap()), s.Stopped)
+}
+
+// isEventStream reports whether contentType is that of server-sent
+// events.
+func isEventStream(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mt == "text/event-stream"
+}
+
+// readEvents reads events from body until maxEvents were read or
+// maxDuration passed, whichever comes first.
+func readEvents(body io.ReadCloser, start time.Time, maxEvents int, maxDuration time.Duration) (*EventStream, error) {
+	if maxEvents <= 0 {
+		maxEvents = defaultSSEMaxEvents
+	}
+	if maxDuration <= 0 {
+		maxDuration = defaultSSEMaxDuration
+	}
+
+	var mu sync.Mutex
+	expired := false
+	timer := time.AfterFunc(maxDuration, func() {
+		mu.Lock()
+		expired = true
+		mu.Unlock()
+
+		// unblocks a pending Read
+		body.Close()
+	})
+	defer timer.Stop()
+
+	s := &EventStream{}
+	ev := Event{Type: "message"}
+	var data []string
+
+	scanner := bufio.NewScanner(body)
+	for len(s.Events) < maxEvents && scanner.Scan() {
+		line := scanner.Text()
+		s.Bytes += int64(len(line)) + 1
+
+		if line == "" {
+			// a blank line dispatches the event, if it has data
+			if data != nil {
+				ev.Offset = time.Since(start)
+				ev.Data = strings.Join(data, "\n")
+				if len(ev.Data) > maxEventData {
+					ev.Data = ev.Data[:maxEventData]
+				}
+				s.Events = append(s.Events, ev)
+			}
+			ev, data = Event{Type: "message", ID: ev.ID}, nil
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i != -1 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "":
+			// comment, often sent to keep the connection alive
+		case "event":
+			ev.Type = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			ev.ID = value
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	switch {
+	case len(s.Events) >= maxEvents:
+		s.Stopped = fmt.Sprintf("stopped after %d events", maxEvents)
+	case expired:
+		s.Stopped = fmt.Sprintf("stopped after %s", fmtms(time.Since(start)))
+	case scanner.Err() != nil:
+		return nil, failf(scanner.Err(), "Failed to read event stream: %v", scanner.Err())
+	default:
+		s.Stopped = "stream ended"
+	}
+	return s, nil
+}
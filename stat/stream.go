@@ -0,0 +1,150 @@
+package stat
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// limits applied to streamed bodies when the Request leaves them unset
+const (
+	defaultStreamMaxDuration = 10 * time.Second
+	defaultStreamMaxBytes    = 1 << 20
+)
+
+// Stream records when the pieces of a response body arrived.
+type Stream struct {
+	Chunks []Chunk `json:"chunks"`
+	Bytes  int64   `json:"bytes"`
+
+	// Stopped tells why reading stopped before the end of the body,
+	// empty if it did not.
+	Stopped string `json:"stopped,omitempty"`
+}
+
+// Chunk is a piece of the body as returned by a single read.
+type Chunk struct {
+	// time since the first response byte
+	Offset time.Duration `json:"offset"`
+	Size   int           `json:"size"`
+}
+
+// MedianGap returns the median time between two consecutive chunks.
+func (s Stream) MedianGap() time.Duration {
+	if len(s.Chunks) < 2 {
+		return 0
+	}
+
+	gaps := make([]time.Duration, 0, len(s.Chunks)-1)
+	for i := 1; i < len(s.Chunks); i++ {
+		gaps = append(gaps, s.Chunks[i].Offset-s.Chunks[i-1].Offset)
+	}
+	sort.Sort(durations(gaps))
+
+	m := len(gaps) / 2
+	if len(gaps)%2 == 0 {
+		return (gaps[m-1] + gaps[m]) / 2
+	}
+	return gaps[m]
+}
+
+func (s Stream) String() string {
+	if len(s.Chunks) == 0 {
+		return "no chunks received"
+	}
+
+	first, last := s.Chunks[0], s.Chunks[len(s.Chunks)-1]
+	msg := fmt.Sprintf("%d chunks, %d bytes; first chunk %s, median gap %s, last chunk %s",
+		len(s.Chunks), s.Bytes, fmtms(first.Offset), fmtms(s.MedianGap()), fmtms(last.Offset))
+	if s.Stopped != "" {
+		msg += " (" + s.Stopped + ")"
+	}
+	return msg
+}
+
+// streamReader wraps a response body, recording a Chunk for every read
+// and cutting the body short once it ran for too long or got too big.
+type streamReader struct {
+	io.ReadCloser
+
+	stream   *Stream
+	start    time.Time
+	maxBytes int64
+
+	mu      sync.Mutex
+	expired bool
+	timer   *time.Timer
+}
+
+func newStreamReader(body io.ReadCloser, start time.Time, maxBytes int64, maxDuration time.Duration) *streamReader {
+	if maxBytes <= 0 {
+		maxBytes = defaultStreamMaxBytes
+	}
+	if maxDuration <= 0 {
+		maxDuration = defaultStreamMaxDuration
+	}
+
+	s := &streamReader{
+		ReadCloser: body,
+		stream:     &Stream{},
+		start:      start,
+		maxBytes:   maxBytes,
+	}
+	s.timer = time.AfterFunc(maxDuration, func() {
+		s.mu.Lock()
+		s.expired = true
+		s.mu.Unlock()
+
+		// unblocks a pending Read
+		body.Close()
+	})
+	return s
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	if s.stream.Bytes >= s.maxBytes {
+		s.stream.Stopped = fmt.Sprintf("stopped after %d bytes", s.maxBytes)
+		return 0, io.EOF
+	}
+	if int64(len(p)) > s.maxBytes-s.stream.Bytes {
+		p = p[:s.maxBytes-s.stream.Bytes]
+	}
+
+	n, err := s.ReadCloser.Read(p)
+	if n > 0 {
+		s.stream.Chunks = append(s.stream.Chunks, Chunk{
+			Offset: time.Since(s.start),
+			Size:   n,
+		})
+		s.stream.Bytes += int64(n)
+	}
+
+	if err != nil && err != io.EOF {
+		s.mu.Lock()
+		expired := s.expired
+		s.mu.Unlock()
+
+		if expired {
+			s.stream.Stopped = fmt.Sprintf("stopped after %s", fmtms(time.Since(s.start)))
+			return n, io.EOF
+		}
+	}
+	return n, err
+}
+
+func (s *streamReader) Close() error {
+	s.timer.Stop()
+	return s.ReadCloser.Close()
+}
+
+type durations []time.Duration
+
+func (d durations) Len() int           { return len(d) }
+func (d durations) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d durations) Less(i, j int) bool { return d[i] < d[j] }
+
+func fmtms(d time.Duration) string {
+	return fmt.Sprintf("%dms", int(d/time.Millisecond))
+}
@@ -0,0 +1,260 @@
+package stat
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Limits of sub-resource discovery and tracing.
+const (
+	// how much of an HTML body is scanned for link tags, which
+	// belong in its head
+	maxSubresourceScan = 64 << 10
+
+	maxSubresourceTraces = 20
+
+	// browsers open about as many connections to a host
+	subresourceConcurrency = 6
+)
+
+// subresourceRels are the link relations declaring a sub-resource.
+var subresourceRels = map[string]bool{"preload": true, "modulepreload": true, "prefetch": true}
+
+// Subresource is a sub-resource a response declares, in a Link header
+// or a link tag of its HTML.
+type Subresource struct {
+	URL string `json:"url"`
+	Rel string `json:"rel"`
+
+	// As is the kind of resource, such as script or style, when given.
+	As string `json:"as,omitempty"`
+
+	// Source is "header" or "html".
+	Source string `json:"source"`
+
+	// the trace of the sub-resource, when traced
+	Traced     bool     `json:"traced"`
+	StatusCode int      `json:"status_code,omitempty"`
+	Timings    *Timings `json:"timings,omitempty"`
+	Err        string   `json:"error,omitempty"`
+
+	sameHost bool // as the response declaring it
+}
+
+func (s Subresource) String() string {
+	line := s.Rel
+	if s.As != "" {
+		line += " " + s.As
+	}
+	line += " " + s.URL
+	switch {
+	case s.Err != "":
+		line += ": " + s.Err
+	case s.Traced:
+		line += fmt.Sprintf(": %d in %s", s.StatusCode, fmtms(s.Timings.Total))
+	}
+	return line
+}
+
+var (
+	linkTag  = regexp.MustCompile(`(?is)<link\s[^>]*>`)
+	linkAttr = regexp.MustCompile(`(?is)\b(rel|href|as)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+)
+
+// findSubresources returns the sub-resources declared by the Link
+// headers of a response from base and, for HTML, by the link tags in
+// head, its first bytes. Relative references are resolved against base,
+// those that are not http or https are left out, as are repeats.
+func findSubresources(base *url.URL, h http.Header, head []byte) []Subresource {
+	var found []Subresource
+	seen := make(map[string]bool)
+	add := func(ref, rels, as, source string) {
+		u, err := base.Parse(strings.TrimSpace(ref))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return
+		}
+		u.Fragment = ""
+		for _, rel := range strings.Fields(strings.ToLower(rels)) {
+			if !subresourceRels[rel] || seen[u.String()] {
+				continue
+			}
+			seen[u.String()] = true
+			found = append(found, Subresource{
+				URL:      u.String(),
+				Rel:      rel,
+				As:       strings.ToLower(as),
+				Source:   source,
+				sameHost: u.Host == base.Host,
+			})
+		}
+	}
+
+	for _, v := range h["Link"] {
+		for _, l := range parseLinks(v) {
+			add(l.ref, l.params["rel"], l.params["as"], "header")
+		}
+	}
+
+	if mt, _, _ := mime.ParseMediaType(h.Get("Content-Type")); mt == "text/html" {
+		for _, tag := range linkTag.FindAll(head, -1) {
+			attrs := make(map[string]string)
+			for _, m := range linkAttr.FindAllSubmatch(tag, -1) {
+				name := strings.ToLower(string(m[1]))
+				if _, ok := attrs[name]; !ok {
+					attrs[name] = string(m[2]) + string(m[3]) + string(m[4])
+				}
+			}
+			if href, ok := attrs["href"]; ok {
+				add(href, attrs["rel"], attrs["as"], "html")
+			}
+		}
+	}
+	return found
+}
+
+// link is a link of a Link header, see RFC 8288.
+type link struct {
+	ref    string
+	params map[string]string // names lowercased, first occurrence kept
+}
+
+// parseLinks parses the links of a Link header value. Links are
+// separated by commas, which may also appear in the target and in
+// quoted parameters, so it is scanned rather than split.
+func parseLinks(v string) []link {
+	var links []link
+	for {
+		start := strings.IndexByte(v, '<')
+		if start == -1 {
+			return links
+		}
+		end := strings.IndexByte(v[start:], '>')
+		if end == -1 {
+			return links
+		}
+		l := link{ref: v[start+1 : start+end], params: make(map[string]string)}
+		v = v[start+end+1:]
+
+		// parameters run up to the next comma outside quotes
+		for {
+			v = strings.TrimLeft(v, " \t")
+			if !strings.HasPrefix(v, ";") {
+				break
+			}
+			v = strings.TrimLeft(v[1:], " \t")
+
+			i := strings.IndexAny(v, "=;,")
+			if i == -1 {
+				i = len(v)
+			}
+			name := strings.ToLower(strings.TrimSpace(v[:i]))
+			v = v[i:]
+
+			var value string
+			if strings.HasPrefix(v, "=") {
+				v = strings.TrimLeft(v[1:], " \t")
+				if strings.HasPrefix(v, `"`) {
+					j := strings.IndexByte(v[1:], '"')
+					if j == -1 {
+						j = len(v) - 1
+					}
+					value, v = v[1:j+1], v[j+1:]
+					v = strings.TrimPrefix(v, `"`)
+				} else {
+					j := strings.IndexAny(v, ";,")
+					if j == -1 {
+						j = len(v)
+					}
+					value, v = strings.TrimSpace(v[:j]), v[j:]
+				}
+			}
+			if _, ok := l.params[name]; !ok && name != "" {
+				l.params[name] = value
+			}
+		}
+		links = append(links, l)
+	}
+}
+
+// traceSubresources traces up to r.TraceSubresources of the
+// sub-resources found in w, a few at a time as a browser would once it
+// got the page, and reports them.
+func (t *Tracer) traceSubresources(ctx context.Context, r *Request, w *Response) {
+	n := r.TraceSubresources
+	if n > maxSubresourceTraces {
+		n = maxSubresourceTraces
+	}
+	if n > len(w.Subresources) {
+		n = len(w.Subresources)
+	}
+
+	// fetched the way a browser would: GET, without the body or the
+	// checks of the page, and with its credentials on the same host only
+	sub := *r
+	sub.HTTPMethod = "GET"
+	sub.PostBody = ""
+	sub.Subresources, sub.TraceSubresources = false, 0
+	sub.Preflight, sub.PreflightOnly = false, false
+	sub.Shadow = ""
+	sub.Assertions = nil
+	sub.AuditHeaders = false
+	sub.IncludeBody = false
+	sub.SSE, sub.Stream, sub.CaptureTail = false, false, false
+	sub.BodyJSONPath = ""
+	sub.Retries = 0
+	sub.RequireTLS = TLSRequirements{}
+	sub.ExpectCertFor = ""
+	sub.ExpiryFailDays = 0
+	sub.AbortOnStatusClass = nil
+
+	start := time.Now()
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < subresourceConcurrency && i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range next {
+				s := &w.Subresources[j]
+				req := sub
+				req.URL, _ = url.Parse(s.URL) // parsed already when found
+				if !s.sameHost {
+					req.dropCredentials()
+				}
+				resp, err := t.Trace(ctx, &req)
+				s.Traced = true
+				if err != nil {
+					s.Err = err.Error()
+					continue
+				}
+				s.StatusCode = resp.StatusCode
+				s.Timings = &resp.Timings
+			}
+		}()
+	}
+	for j := 0; j < n; j++ {
+		next <- j
+	}
+	close(next)
+	wg.Wait()
+
+	took := time.Since(start)
+	w.report("Sub-resources: %d declared, %d traced in %s, page and sub-resources %s",
+		len(w.Subresources), n, fmtms(took), fmtms(w.Timings.Total+took))
+	reportSubresources(w)
+}
+
+// reportSubresources lists the sub-resources found in w.
+func reportSubresources(w *Response) {
+	for _, s := range w.Subresources {
+		w.report("  %s", s)
+	}
+}
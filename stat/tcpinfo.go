@@ -0,0 +1,82 @@
+package stat
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+)
+
+// TCPInfo holds the statistics the kernel keeps for the connection of
+// the final hop, read once the response has been received.
+type TCPInfo struct {
+	// Available is false on platforms that do not expose the
+	// statistics, and for connections that are not plain TCP sockets.
+	Available bool `json:"available"`
+
+	// round-trip time and its variation as smoothed by the kernel
+	RTT    time.Duration `json:"rtt"`
+	RTTVar time.Duration `json:"rtt_var"`
+
+	// segments retransmitted over the life of the connection, and
+	// those currently thought lost
+	Retransmits uint32 `json:"retransmits"`
+	Lost        uint32 `json:"lost"`
+
+	// FastOpen is set when the SYN carried data the server
+	// acknowledged, see Request.TCPFastOpen.
+	FastOpen bool `json:"fast_open"`
+}
+
+func (i *TCPInfo) String() string {
+	if !i.Available {
+		return "unavailable"
+	}
+	return fmt.Sprintf("rtt %s (var %s), %d retransmitted, %d lost",
+		fmtms(i.RTT), fmtms(i.RTTVar), i.Retransmits, i.Lost)
+}
+
+// newTCPInfo reads the statistics of the socket under conn.
+func newTCPInfo(conn net.Conn) *TCPInfo {
+	if tc, ok := conn.(*tls.Conn); ok {
+		conn = tc.NetConn()
+	}
+	tcp, ok := conn.(*net.TCPConn)
+	if !ok {
+		return &TCPInfo{}
+	}
+	return readTCPInfo(tcp)
+}
+
+// fastOpenControl is a net.Dialer.Control function asking for TCP Fast
+// Open before calling next, if any. Platforms without it dial as usual.
+func fastOpenControl(next func(string, string, syscall.RawConn) error) func(string, string, syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		if next != nil {
+			if err := next(network, address, c); err != nil {
+				return err
+			}
+		}
+		setFastOpen(c)
+		return nil
+	}
+}
+
+// reportFastOpen tells whether the connection of the final hop used TCP
+// Fast Open.
+func reportFastOpen(w *Response, conn net.Conn) {
+	switch info := newTCPInfo(conn); {
+	case w.Reused:
+		w.FastOpen = "not applicable"
+		w.report("TCP Fast Open: not applicable, connection reused")
+		return
+	case !info.Available:
+		w.FastOpen = "unavailable"
+	case info.FastOpen:
+		w.FastOpen = "used"
+	default:
+		w.FastOpen = "not used"
+	}
+	w.report("TCP Fast Open: %s, connect %s", w.FastOpen, fmtms(w.Timings.TCPConnection))
+}
@@ -0,0 +1,58 @@
+//go:build linux && (amd64 || arm64)
+
+package stat
+
+import (
+	"net"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+func readTCPInfo(conn *net.TCPConn) *TCPInfo {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return &TCPInfo{}
+	}
+
+	var ti syscall.TCPInfo
+	var errno syscall.Errno
+	err = raw.Control(func(fd uintptr) {
+		size := uint32(syscall.SizeofTCPInfo)
+		_, _, errno = syscall.Syscall6(syscall.SYS_GETSOCKOPT, fd,
+			syscall.IPPROTO_TCP, syscall.TCP_INFO,
+			uintptr(unsafe.Pointer(&ti)), uintptr(unsafe.Pointer(&size)), 0)
+	})
+	if err != nil || errno != 0 {
+		return &TCPInfo{}
+	}
+
+	// the kernel reports times in microseconds
+	return &TCPInfo{
+		Available:   true,
+		RTT:         time.Duration(ti.Rtt) * time.Microsecond,
+		RTTVar:      time.Duration(ti.Rttvar) * time.Microsecond,
+		Retransmits: ti.Total_retrans,
+		Lost:        ti.Lost,
+		FastOpen:    ti.Options&tcpiOptSynData != 0,
+	}
+}
+
+const (
+	tcpFastOpenConnect = 30   // TCP_FASTOPEN_CONNECT, Linux 4.11
+	tcpiOptSynData     = 0x20 // TCPI_OPT_SYN_DATA
+)
+
+// setFastOpen has connect return at once, so that the first write goes
+// out with the SYN when the kernel has a Fast Open cookie for the
+// server.
+func setFastOpen(c syscall.RawConn) error {
+	var serr error
+	err := c.Control(func(fd uintptr) {
+		serr = syscall.SetsockoptInt(int(fd), syscall.IPPROTO_TCP, tcpFastOpenConnect, 1)
+	})
+	if err != nil {
+		return err
+	}
+	return serr
+}
@@ -0,0 +1,17 @@
+//go:build !linux || !(amd64 || arm64)
+
+package stat
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+func readTCPInfo(*net.TCPConn) *TCPInfo {
+	return &TCPInfo{}
+}
+
+func setFastOpen(syscall.RawConn) error {
+	return errors.New("TCP Fast Open is not supported on this platform")
+}
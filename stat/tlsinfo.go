@@ -0,0 +1,266 @@
+package stat
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TLSInfo describes the TLS session of the final hop.
+type TLSInfo struct {
+	// negotiated protocol version and cipher suite
+	Version     string `json:"version"`
+	CipherSuite string `json:"cipher_suite"`
+
+	// subject, issuer and expiry of the leaf certificate
+	Subject       string    `json:"subject"`
+	Issuer        string    `json:"issuer"`
+	NotAfter      time.Time `json:"not_after"`
+	DaysRemaining int       `json:"days_remaining"`
+
+	// VerifyError is why verifying the certificate would have failed,
+	// only checked when Request.Insecure skipped verification.
+	VerifyError string `json:"verify_error,omitempty"`
+
+	// ClientCertRequested is set when the server asked for a client
+	// certificate, ClientCertSent when one was offered in reply.
+	ClientCertRequested bool `json:"client_cert_requested"`
+	ClientCertSent      bool `json:"client_cert_sent"`
+
+	// RenegotiationAttempted is set when the server asked to
+	// renegotiate the session, which is refused unless a client
+	// certificate is configured.
+	RenegotiationAttempted bool `json:"renegotiation_attempted"`
+
+	// Verification is the part of the TLS handshake spent verifying
+	// the certificate chain, zero for a reused connection or when
+	// verification is skipped.
+	Verification time.Duration `json:"verification"`
+
+	// details of the handshake that set up the session
+	Handshake *TLSHandshake `json:"handshake"`
+
+	// SANs are the names and addresses of the leaf certificate.
+	// CertForExpected is set when it is valid for Request.ExpectCertFor.
+	SANs            []string `json:"sans,omitempty"`
+	CertForExpected bool     `json:"cert_for_expected,omitempty"`
+
+	// Chain is the certificates the server presented, leaf first, as
+	// presented: it may miss intermediates, or hold ones not needed.
+	Chain []ChainCert `json:"chain,omitempty"`
+
+	// FalseStart tells whether the request was sent before the server
+	// finished the handshake, one of the FalseStart constants.
+	FalseStart string `json:"false_start"`
+
+	// Resumed and EarlyData are only set with Request.EarlyData.
+	Resumed   bool   `json:"resumed,omitempty"`
+	EarlyData string `json:"early_data,omitempty"`
+}
+
+// ChainCert is a certificate of TLSInfo.Chain.
+type ChainCert struct {
+	Subject       string    `json:"subject"`
+	Issuer        string    `json:"issuer"`
+	SANs          []string  `json:"sans,omitempty"`
+	NotBefore     time.Time `json:"not_before"`
+	NotAfter      time.Time `json:"not_after"`
+	DaysRemaining int       `json:"days_remaining"`
+
+	// CA is set for a certificate authority, SelfSigned for one that
+	// issued itself, a root that need not be presented.
+	CA         bool `json:"ca"`
+	SelfSigned bool `json:"self_signed"`
+}
+
+func newChainCert(c *x509.Certificate, now time.Time) ChainCert {
+	cc := ChainCert{
+		Subject:       c.Subject.String(),
+		Issuer:        c.Issuer.String(),
+		SANs:          append([]string(nil), c.DNSNames...),
+		NotBefore:     c.NotBefore,
+		NotAfter:      c.NotAfter,
+		DaysRemaining: int(c.NotAfter.Sub(now).Hours() / 24),
+		CA:            c.IsCA,
+	}
+	for _, ip := range c.IPAddresses {
+		cc.SANs = append(cc.SANs, ip.String())
+	}
+	if bytes.Equal(c.RawSubject, c.RawIssuer) {
+		cc.SelfSigned = c.CheckSignatureFrom(c) == nil
+	}
+	return cc
+}
+
+func (c ChainCert) String() string {
+	s := fmt.Sprintf("%s, issued by %s, expires %s (%d days)",
+		c.Subject, c.Issuer, c.NotAfter.Format("2006-01-02"), c.DaysRemaining)
+	if c.SelfSigned {
+		s += ", self-signed"
+	}
+	return s
+}
+
+// Outcomes of TLS 1.3 early data for TLSInfo.EarlyData.
+const (
+	EarlyDataAccepted     = "accepted"
+	EarlyDataRejected     = "rejected"
+	EarlyDataNotAttempted = "not attempted"
+)
+
+// Values of TLSInfo.FalseStart.
+const (
+	FalseStartUsed          = "yes"
+	FalseStartNotUsed       = "no"
+	FalseStartUnknown       = "unknown"
+	FalseStartNotApplicable = "not applicable"
+)
+
+// falseStart tells whether a session used TLS False Start. TLS 1.3 has
+// no such thing, and crypto/tls never does it for earlier versions: it
+// writes nothing before the server's Finished message. Over a provided
+// connection the handshake was made by another stack, out of sight.
+func falseStart(cs *tls.ConnectionState, provided bool) string {
+	switch {
+	case provided:
+		return FalseStartUnknown
+	case cs.Version >= tls.VersionTLS13:
+		return FalseStartNotApplicable
+	default:
+		return FalseStartNotUsed
+	}
+}
+
+// unknown stands for handshake details crypto/tls does not expose.
+const unknown = "unknown"
+
+// TLSHandshake describes the parameters the server chose in its
+// ServerHello, as far as crypto/tls exposes them.
+type TLSHandshake struct {
+	Version     string `json:"version"`
+	CipherSuite string `json:"cipher_suite"`
+
+	// Group is the key exchange group, X25519 for one.
+	Group string `json:"group"`
+
+	// SignatureScheme the server signed the handshake with, always
+	// unknown as crypto/tls keeps it to itself.
+	SignatureScheme string `json:"signature_scheme"`
+
+	// CertificateKey is the public key algorithm of the leaf
+	// certificate, ECDSA or RSA for instance.
+	CertificateKey string `json:"certificate_key"`
+
+	// ALPN is the protocol agreed on by ALPN, empty when the server
+	// did not take part.
+	ALPN string `json:"alpn"`
+
+	HelloRetryRequest bool `json:"hello_retry_request"`
+	ECHAccepted       bool `json:"ech_accepted"`
+}
+
+func newTLSHandshake(cs *tls.ConnectionState) *TLSHandshake {
+	h := &TLSHandshake{
+		Version:           tlsVersionName(cs.Version),
+		CipherSuite:       tls.CipherSuiteName(cs.CipherSuite),
+		Group:             unknown,
+		SignatureScheme:   unknown,
+		CertificateKey:    unknown,
+		HelloRetryRequest: cs.HelloRetryRequest,
+		ECHAccepted:       cs.ECHAccepted,
+	}
+	if cs.CurveID != 0 {
+		h.Group = cs.CurveID.String()
+	}
+	if len(cs.PeerCertificates) > 0 {
+		h.CertificateKey = cs.PeerCertificates[0].PublicKeyAlgorithm.String()
+	}
+	if cs.NegotiatedProtocolIsMutual {
+		h.ALPN = cs.NegotiatedProtocol
+	}
+	return h
+}
+
+func (h *TLSHandshake) String() string {
+	alpn := h.ALPN
+	if alpn == "" {
+		alpn = "not used"
+	}
+	return fmt.Sprintf("%s, %s, group %s, signature %s, %s certificate, ALPN %s",
+		h.Version, h.CipherSuite, h.Group, h.SignatureScheme, h.CertificateKey, alpn)
+}
+
+func newTLSInfo(cs *tls.ConnectionState, now time.Time) *TLSInfo {
+	info := &TLSInfo{
+		Version:     tlsVersionName(cs.Version),
+		CipherSuite: tls.CipherSuiteName(cs.CipherSuite),
+		Handshake:   newTLSHandshake(cs),
+	}
+	for _, c := range cs.PeerCertificates {
+		info.Chain = append(info.Chain, newChainCert(c, now))
+	}
+	if len(info.Chain) > 0 {
+		leaf := info.Chain[0]
+		info.Subject = leaf.Subject
+		info.Issuer = leaf.Issuer
+		info.NotAfter = leaf.NotAfter
+		info.DaysRemaining = leaf.DaysRemaining
+		info.SANs = leaf.SANs
+	}
+	return info
+}
+
+// reportTLS reports the session and the certificates, for
+// Request.ShowTLS.
+func reportTLS(w *Response) {
+	info := w.TLS
+	w.report("TLS: %s, %s", info.Version, info.CipherSuite)
+	if alpn := info.Handshake.ALPN; alpn != "" {
+		w.report("  ALPN: %s", alpn)
+	} else {
+		w.report("  ALPN: not used")
+	}
+	w.report("  TLS False Start: %s", info.FalseStart)
+	if info.Subject == "" {
+		w.report("  Certificate: none presented")
+		return
+	}
+	w.report("  Subject: %s", info.Subject)
+	w.report("  Issuer: %s", info.Issuer)
+	w.report("  Expires: %s (%d days)", info.NotAfter.Format(time.RFC3339), info.DaysRemaining)
+	w.report("  SANs: %s", strings.Join(info.SANs, ", "))
+	w.report("  Chain:")
+	for i, c := range info.Chain {
+		w.report("    %d: %s", i, c)
+	}
+	if info.VerifyError != "" {
+		w.report("  Verification skipped, would have failed: %s", info.VerifyError)
+	}
+}
+
+// isRenegotiation reports whether err comes from refusing a server's
+// request to renegotiate. crypto/tls answers a HelloRequest with a
+// no_renegotiation alert and fails the connection with that alert.
+func isRenegotiation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "no renegotiation")
+}
+
+// checkCertFor reports whether the leaf certificate covers
+// ExpectCertFor, listing the names it does cover.
+func (r *Request) checkCertFor(w *Response, cs *tls.ConnectionState) {
+	if len(cs.PeerCertificates) == 0 {
+		w.report("Certificate for %s: no certificate presented", r.ExpectCertFor)
+		return
+	}
+	leaf := cs.PeerCertificates[0]
+
+	if err := leaf.VerifyHostname(r.ExpectCertFor); err != nil {
+		w.report("Certificate for %s: fail, SANs: %s", r.ExpectCertFor, strings.Join(w.TLS.SANs, ", "))
+		return
+	}
+	w.TLS.CertForExpected = true
+	w.report("Certificate for %s: pass, SANs: %s", r.ExpectCertFor, strings.Join(w.TLS.SANs, ", "))
+}
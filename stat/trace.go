@@ -1,6 +1,8 @@
 package stat
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/pem"
 	"fmt"
@@ -9,38 +11,24 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
-)
-
-func main() {
-	r := NewRequest(os.Args[1])
-	fmt.Println(Trace(r))
-}
-
-func makePanic(desc string, argv ...interface{}) {
-	panic(fmt.Sprintf(desc, argv...))
-}
-
-func Trace(r *Request) *Response {
-	if (r.HTTPMethod == "POST" || r.HTTPMethod == "PUT") && r.PostBody == "" {
-		makePanic("Must supply post body using -d when POST or PUT is used")
-	}
 
-	if r.OnlyHeader {
-		r.HTTPMethod = "HEAD"
-	}
+	"golang.org/x/net/context"
+)
 
-	resp := &Response{}
-	r.visit(resp)
-	return resp
+// Trace performs r with a Tracer of its own, so that no connection is
+// shared with other traces. See Tracer.Trace.
+func Trace(ctx context.Context, r *Request) (*Response, error) {
+	t := NewTracer()
+	defer t.CloseIdleConnections()
+	return t.Trace(ctx, r)
 }
 
 // readClientCert - helper function to read client certificate
 // from pem formatted file
-func readClientCert(filename string) []tls.Certificate {
+func readClientCert(filename string) ([]tls.Certificate, error) {
 	if filename == "" {
-		return nil
+		return nil, nil
 	}
 	var (
 		pkeyPem []byte
@@ -50,7 +38,7 @@ func readClientCert(filename string) []tls.Certificate {
 	// read client certificate file (must include client private key and certificate)
 	certFileBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
-		makePanic("Failed to read client certificate file: %v", err)
+		return nil, invalidf("Failed to read client certificate file: %v", err)
 	}
 
 	for {
@@ -70,20 +58,20 @@ func readClientCert(filename string) []tls.Certificate {
 
 	cert, err := tls.X509KeyPair(certPem, pkeyPem)
 	if err != nil {
-		makePanic("Unable to load client cert and key pair: %v", err)
+		return nil, invalidf("Unable to load client cert and key pair: %v", err)
 	}
 
-	return []tls.Certificate{cert}
+	return []tls.Certificate{cert}, nil
 }
 
-func parseURL(uri string) *url.URL {
+func parseURL(uri string) (*url.URL, error) {
 	if !strings.Contains(uri, "://") && !strings.HasPrefix(uri, "//") {
 		uri = "//" + uri
 	}
 
 	url, err := url.Parse(uri)
 	if err != nil {
-		makePanic("Could not parse url %q: %v", uri, err)
+		return nil, invalidf("Could not parse url %q: %v", uri, err)
 	}
 
 	if url.Scheme == "" {
@@ -92,17 +80,28 @@ func parseURL(uri string) *url.URL {
 			url.Scheme += "s"
 		}
 	}
-	return url
+	return url, nil
 }
 
 func headerKeyValue(h string) (string, string) {
 	i := strings.Index(h, ":")
 	if i == -1 {
-		makePanic("Header '%s' has invalid format, missing ':'", h)
+		// refused by validateHeaders
+		return strings.TrimSpace(h), ""
 	}
 	return strings.TrimRight(h[:i], " "), strings.TrimLeft(h[i:], " :")
 }
 
+// validateHeaders checks every header is a "Name: value" line.
+func validateHeaders(headers Headers) error {
+	for _, h := range headers {
+		if !strings.Contains(h, ":") {
+			return invalidf("Header '%s' has invalid format, missing ':'", h)
+		}
+	}
+	return nil
+}
+
 func isRedirect(resp *http.Response) bool {
 	return resp.StatusCode > 299 && resp.StatusCode < 400
 }
@@ -130,20 +129,93 @@ func getFilenameFromHeaders(headers http.Header) string {
 	return ""
 }
 
-// readResponseBody consumes the body of the response.
-// readResponseBody returns an informational message about the
-// disposition of the response body's contents.
-func readResponseBody(req *http.Request, resp *http.Response) string {
-	if isRedirect(resp) || req.Method == http.MethodHead {
-		return ""
+// bodyRead is what readResponseBody learned about a response body.
+type bodyRead struct {
+	msg  string // informational message about the body's disposition
+	size int64  // bytes read
+	head []byte // the first bytes, sniffLen at least, for content sniffing
+	body *Body  // captured content, only when asked for
+	sum  []byte // SHA-256 of the whole body
+
+	// the server tried to renegotiate TLS while sending the body
+	renegotiation bool
+}
+
+// readResponseBody consumes the body of the response, hashing all of it
+// and keeping its first head bytes, sniffLen at least, for content
+// sniffing. When capture is greater than zero, it also keeps up to
+// capture bytes of the body itself: its first bytes, or its last ones
+// when tail is set.
+func readResponseBody(req *http.Request, resp *http.Response, capture, head int64, tail bool) (bodyRead, error) {
+	if req.Method == http.MethodHead {
+		return bodyRead{}, nil
 	}
 
-	w := ioutil.Discard
 	msg := "Body discarded"
+	if capture > 0 {
+		msg = "Body captured"
+	}
+
+	if head < sniffLen {
+		head = sniffLen
+	}
+	limit := capture
+	if tail || limit < head {
+		limit = head
+	}
+
+	var buf bytes.Buffer
+	h := sha256.New()
+	w := io.MultiWriter(&limitedWriter{&buf, limit}, h)
+
+	var ring *ringWriter
+	if tail && capture > 0 {
+		msg = "Body tail captured"
+		ring = newRingWriter(capture)
+		w = io.MultiWriter(w, ring)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	renegotiation := isRenegotiation(err)
+	if renegotiation {
+		msg = "Body incomplete, server attempted TLS renegotiation which was refused"
+	} else if err != nil {
+		return bodyRead{}, failf(err, "Failed to read response body: %v", err)
+	}
+
+	read := bodyRead{msg: msg, size: n, head: buf.Bytes(), sum: h.Sum(nil), renegotiation: renegotiation}
+	if int64(len(read.head)) > head {
+		read.head = read.head[:head]
+	}
 
-	if _, err := io.Copy(w, resp.Body); err != nil {
-		makePanic("Failed to read response body: %v", err)
+	switch {
+	case ring != nil:
+		read.body = newBody(ring.Bytes(), n, true)
+	case capture > 0:
+		b := buf.Bytes()
+		if int64(len(b)) > capture {
+			b = b[:capture]
+		}
+		read.body = newBody(b, n, false)
 	}
+	return read, nil
+}
 
-	return msg
+// maxRedirectBody is how much of a redirect's body is read to keep its
+// connection reusable; anything larger is not worth downloading.
+const maxRedirectBody = 4 << 10
+
+// drainRedirectBody discards the body of a redirect that is about to be
+// followed and returns a note on how much of it there was.
+func drainRedirectBody(resp *http.Response) (bodyRead, error) {
+	// keep what was read, it may hold a meta refresh
+	var buf bytes.Buffer
+	n, err := io.CopyN(&buf, resp.Body, maxRedirectBody)
+	if err == io.EOF {
+		return bodyRead{msg: fmt.Sprintf("Redirect body drained (%d bytes)", n), size: n, head: buf.Bytes()}, nil
+	}
+	if err != nil {
+		return bodyRead{}, failf(err, "Failed to read response body: %v", err)
+	}
+	return bodyRead{msg: fmt.Sprintf("Redirect body larger than %d bytes, skipped", maxRedirectBody), size: n, head: buf.Bytes()}, nil
 }
@@ -0,0 +1,392 @@
+package stat
+
+import (
+	stdcontext "context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"golang.org/x/net/context"
+)
+
+// A Tracer traces requests over transports it owns, so that connections
+// are pooled and reused between traces.
+//
+// A Tracer is safe for concurrent use by multiple goroutines. Each call
+// to Trace works on its own copy of the Request, but a Request must not
+// be modified while it is being traced.
+type Tracer struct {
+	// Policy restricts what traces may do, DefaultPolicy when nil.
+	Policy *Policy
+
+	mu         sync.Mutex
+	transports map[transportKey]*transport
+}
+
+// transport is an http.Transport along with what its TLS configuration
+// observed outside of any trace.
+type transport struct {
+	*http.Transport
+
+	// sends requests in place of the http.Transport, for ForceHTTP3
+	h3 *http3.Transport
+
+	// client certificate requests made while renegotiating
+	renegotiations int32 // accessed atomically
+
+	// times certificate verification, nil when it is skipped
+	verifier *verifier
+
+	// writes TLS secrets, nil unless asked for
+	keyLog *keyLogWriter
+}
+
+// transportKey holds the Request options that end up in the
+// configuration of a transport; requests sharing a key share a
+// connection pool.
+type transportKey struct {
+	serverName     string
+	insecure       bool
+	clientCertFile string
+	http2          HTTP2Settings
+	http2Streams   bool
+	forceHTTP      string
+	denyPrivate    bool
+	network        string
+	socks5         string
+	ipHost         string
+	resumption     bool
+	keyLogFile     string
+	dnsTimeout     time.Duration
+	fastOpen       bool
+	happyEyeballs  bool
+}
+
+// RoundTrip sends req over HTTP/3 when tr is for it.
+func (tr *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if tr.h3 != nil {
+		return tr.h3.RoundTrip(req)
+	}
+	return tr.Transport.RoundTrip(req)
+}
+
+func (tr *transport) CloseIdleConnections() {
+	if tr.h3 != nil {
+		tr.h3.CloseIdleConnections()
+	}
+	tr.Transport.CloseIdleConnections()
+}
+
+func NewTracer() *Tracer {
+	return &Tracer{
+		transports: make(map[transportKey]*transport),
+	}
+}
+
+// Trace performs r and reports how long each phase took. Cancelling
+// ctx aborts the trace wherever it is. Errors are *TraceError, whose
+// Kind tells what failed.
+func (t *Tracer) Trace(ctx context.Context, r *Request) (*Response, error) {
+	req := *r
+
+	ctx, cancel, bound := req.withDeadline(ctx)
+	defer cancel()
+
+	assertions, err := t.validate(&req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.OnlyHeader {
+		req.HTTPMethod = "HEAD"
+	}
+
+	var preflight *Preflight
+	if req.Preflight || req.PreflightOnly {
+		preflight = t.preflight(ctx, &req, req.origin())
+		if req.PreflightOnly {
+			if preflight.Err != "" {
+				return nil, traceError(failedf("%s", preflight.Err), ctx, bound)
+			}
+			resp := preflight.Response
+			resp.Preflight = preflight
+			resp.report("%s", preflight)
+			return resp, nil
+		}
+	}
+
+	var shadow <-chan *Shadow
+	if req.Shadow != "" {
+		shadow = t.shadow(ctx, &req)
+	}
+
+	resp := &Response{}
+	if preflight != nil {
+		resp.Preflight = preflight
+		resp.report("%s\n", preflight)
+	}
+	if err := req.visitChain(ctx, t, resp); err != nil {
+		return nil, traceError(err, ctx, bound)
+	}
+
+	if shadow != nil {
+		resp.Shadow = (<-shadow).compare(resp, req.ShadowHeaders)
+		resp.report("%s", resp.Shadow)
+	}
+
+	resp.report("Final URL: %s", resp.FinalURL)
+
+	if req.TraceSubresources > 0 && len(resp.Subresources) > 0 {
+		t.traceSubresources(ctx, &req, resp)
+	}
+
+	for _, a := range assertions {
+		res := a.Eval(resp)
+		resp.Assertions = append(resp.Assertions, res)
+		resp.report("%s", res)
+	}
+	return resp, nil
+}
+
+// Traces returns how many traces Trace runs at once at most for r: its
+// own and that of its shadow, then those of its sub-resources.
+func (r *Request) Traces() int {
+	n := 1
+	if r.Shadow != "" {
+		n++
+	}
+	subs := r.TraceSubresources
+	if subs > maxSubresourceTraces {
+		subs = maxSubresourceTraces
+	}
+	if subs > subresourceConcurrency {
+		subs = subresourceConcurrency
+	}
+	if subs > n {
+		n = subs
+	}
+	return n
+}
+
+// validate checks the options of r, returning its parsed assertions.
+func (t *Tracer) validate(r *Request) ([]*Assertion, error) {
+	if (r.HTTPMethod == "POST" || r.HTTPMethod == "PUT") && r.PostBody == "" {
+		return nil, invalidf("Must supply post body using -d when POST or PUT is used")
+	}
+
+	if err := r.HTTP2.validate(); err != nil {
+		return nil, err
+	}
+	if r.Cookies != "" {
+		if err := validateCookies(r.Cookies); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateResolvers(r.Resolvers); err != nil {
+		return nil, err
+	}
+	if err := validateStatusClasses(r.AbortOnStatusClass); err != nil {
+		return nil, err
+	}
+	if err := validateForceHTTP(r); err != nil {
+		return nil, err
+	}
+	if r.ExpectTTLMin < 0 || r.ExpectTTLMax < 0 {
+		return nil, invalidf("ExpectTTLMin and ExpectTTLMax must not be negative")
+	}
+	if r.ExpectTTLMax > 0 && r.ExpectTTLMin > r.ExpectTTLMax {
+		return nil, invalidf("ExpectTTLMin %s is above ExpectTTLMax %s", r.ExpectTTLMin, r.ExpectTTLMax)
+	}
+	if r.SOCKS5 != "" {
+		if err := validateSOCKS5(r.SOCKS5); err != nil {
+			return nil, err
+		}
+	}
+	if r.Shadow != "" {
+		if err := validateShadow(r); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.policy().check(r); err != nil {
+		return nil, err
+	}
+	if r.BodyJSONPath != "" {
+		if _, err := compileJSONPath(r.BodyJSONPath); err != nil {
+			return nil, invalidf("Invalid BodyJSONPath %s: %v", r.BodyJSONPath, err)
+		}
+	}
+	assertions, err := validateAssertions(r.Assertions)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateHeaders(r.HTTPHeaders); err != nil {
+		return nil, err
+	}
+	if err := validateAuth(r); err != nil {
+		return nil, err
+	}
+	if (r.Preflight || r.PreflightOnly) && r.origin() == "" {
+		return nil, invalidf("Preflight needs an Origin")
+	}
+	return assertions, nil
+}
+
+func (t *Tracer) policy() *Policy {
+	if t.Policy != nil {
+		return t.Policy
+	}
+	return &DefaultPolicy
+}
+
+// CloseIdleConnections closes the idle connections of all transports
+// owned by t.
+func (t *Tracer) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, tr := range t.transports {
+		tr.CloseIdleConnections()
+	}
+}
+
+// transport returns the transport to use for r, creating it on
+// first use.
+func (t *Tracer) transport(r *Request) (*transport, error) {
+	key := transportKey{
+		serverName:     r.serverName(),
+		insecure:       r.Insecure,
+		clientCertFile: r.ClientCertFile,
+		http2:          r.HTTP2,
+		http2Streams:   r.HTTP2Streams,
+		forceHTTP:      r.ForceHTTP,
+		denyPrivate:    !t.policy().AllowPrivateTargets,
+		network:        r.network(),
+		socks5:         r.SOCKS5,
+		resumption:     r.EarlyData,
+		keyLogFile:     r.keyLogFile(),
+		dnsTimeout:     r.DNSTimeout,
+		fastOpen:       r.TCPFastOpen,
+		happyEyeballs:  r.HappyEyeballs && r.IPVersion == 0,
+	}
+	if host := r.URL.Hostname(); key.serverName == "" && net.ParseIP(host) != nil {
+		key.ipHost = host
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if tr, ok := t.transports[key]; ok {
+		return tr, nil
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if key.denyPrivate {
+		dialer.Control = controlDial
+	}
+	if key.fastOpen {
+		dialer.Control = fastOpenControl(dialer.Control)
+	}
+
+	tr := &transport{}
+	tr.Transport = &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           familyDialer{dialer, key.network, key.dnsTimeout}.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			ServerName:         key.serverName,
+			InsecureSkipVerify: key.insecure,
+		},
+	}
+
+	if !key.insecure {
+		// verify in our own callback to time it
+		tr.verifier = &verifier{ipHost: key.ipHost}
+		tr.TLSClientConfig.InsecureSkipVerify = true
+		tr.TLSClientConfig.VerifyConnection = tr.verifier.verifyConnection
+	}
+
+	if key.happyEyeballs {
+		tr.DialContext = eyeballsDialer{dialer}.DialContext
+	}
+
+	if key.keyLogFile != "" {
+		tr.keyLog = &keyLogWriter{name: key.keyLogFile}
+		tr.TLSClientConfig.KeyLogWriter = tr.keyLog
+	}
+
+	if key.resumption {
+		tr.TLSClientConfig.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+	}
+
+	certs, err := readClientCert(key.clientCertFile)
+	if err != nil {
+		return nil, err
+	}
+	tr.TLSClientConfig.GetClientCertificate = tr.clientCertificate(certs)
+	if certs != nil {
+		// servers may ask for the certificate by renegotiating
+		tr.TLSClientConfig.Renegotiation = tls.RenegotiateOnceAsClient
+	}
+
+	if key.forceHTTP == ForceHTTP3 {
+		// the TLS configuration carries over, ALPN aside
+		tr.h3 = &http3.Transport{
+			TLSClientConfig: tr.TLSClientConfig,
+			Dial: quicDialer{
+				familyDialer: familyDialer{&net.Dialer{}, strings.Replace(key.network, "tcp", "udp", 1), key.dnsTimeout},
+				denyPrivate:  key.denyPrivate,
+			}.Dial,
+		}
+		t.transports[key] = tr
+		return tr, nil
+	}
+
+	if key.socks5 != "" {
+		tr.Proxy = nil
+		tr.DialContext = socksDialer{dialer, key.socks5, key.network}.DialContext
+	}
+
+	// Because we create a custom TLSClientConfig, we have to opt-in to HTTP/2.
+	// See https://github.com/golang/go/issues/14275
+	if key.forceHTTP == ForceHTTP1 {
+		tr.TLSClientConfig.NextProtos = []string{"http/1.1"}
+	} else if err := configureHTTP2(tr.Transport, key.http2, key.http2Streams); err != nil {
+		return nil, failedf("Failed to prepare transport for HTTP/2: %v", err)
+	}
+	if key.forceHTTP == ForceHTTP2 {
+		tr.TLSClientConfig.NextProtos = []string{"h2"}
+	}
+
+	t.transports[key] = tr
+	return tr, nil
+}
+
+// connTransport returns a transport sending every request over a
+// connection from dial, for Request.Conn. Its connections are not
+// pooled with those of the Tracer.
+func connTransport(dial func(context.Context) (net.Conn, error), s HTTP2Settings, streams bool) (*transport, error) {
+	dialContext := func(ctx stdcontext.Context, _, _ string) (net.Conn, error) {
+		return dial(ctx)
+	}
+
+	tr := &transport{}
+	tr.Transport = &http.Transport{
+		DialContext:     dialContext,
+		DialTLSContext:  dialContext,
+		TLSClientConfig: &tls.Config{},
+	}
+	if err := configureHTTP2(tr.Transport, s, streams); err != nil {
+		return nil, failedf("Failed to prepare transport for HTTP/2: %v", err)
+	}
+	return tr, nil
+}
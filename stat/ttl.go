@@ -0,0 +1,97 @@
+package stat
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+var dnsTypeNames = map[uint16]string{dnsTypeA: "A", dnsTypeCNAME: "CNAME", dnsTypeAAAA: "AAAA"}
+
+// DNSRecord is one of the records the URL host resolves through.
+type DNSRecord struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Data string `json:"data"`
+
+	// TTL is as answered by the DNS server. A caching resolver answers
+	// with what remains of it, the authoritative TTL at most.
+	TTL time.Duration `json:"ttl"`
+}
+
+func (r DNSRecord) String() string {
+	return fmt.Sprintf("%s %s %s, TTL %s", r.Name, r.Type, r.Data, r.TTL)
+}
+
+// lookupTTL reports the records the URL host resolves through, CNAMEs
+// included, along with their TTL, and warns of those outside the range
+// expected.
+func (r *Request) lookupTTL(ctx context.Context, w *Response) {
+	// those of an earlier hop
+	w.DNSRecords = nil
+
+	host := r.URL.Hostname()
+	if net.ParseIP(host) != nil {
+		w.report("DNS TTL: none, %s is an address", host)
+		return
+	}
+
+	qtypes := []uint16{dnsTypeA, dnsTypeAAAA}
+	switch r.IPVersion {
+	case IPv4:
+		qtypes = qtypes[:1]
+	case IPv6:
+		qtypes = qtypes[1:]
+	}
+
+	// the CNAMEs come again in the answer of each type
+	seen := make(map[string]bool)
+	for _, qtype := range qtypes {
+		answers, err := queryDNS(ctx, r.DNSServer, host, qtype)
+		if err != nil {
+			w.report("DNS TTL lookup of %s records failed: %v", dnsTypeNames[qtype], err)
+			continue
+		}
+		for _, rr := range answers {
+			typ, ok := dnsTypeNames[rr.Type]
+			key := rr.Name + " " + typ + " " + rr.Data
+			if !ok || seen[key] {
+				continue
+			}
+			seen[key] = true
+			w.DNSRecords = append(w.DNSRecords, DNSRecord{
+				Name: strings.TrimSuffix(rr.Name, "."),
+				Type: typ,
+				Data: strings.TrimSuffix(rr.Data, "."),
+				TTL:  time.Duration(rr.TTL) * time.Second,
+			})
+		}
+	}
+
+	for _, rec := range w.DNSRecords {
+		w.report("DNS TTL: %s", rec)
+		switch {
+		case r.ExpectTTLMin > 0 && rec.TTL < r.ExpectTTLMin:
+			w.warn(WarnDNSTTL, "%s %s TTL of %s is below %s, clients resolve it again that often",
+				rec.Name, rec.Type, rec.TTL, r.ExpectTTLMin)
+		case r.ExpectTTLMax > 0 && rec.TTL > r.ExpectTTLMax:
+			w.warn(WarnDNSTTL, "%s %s TTL of %s is above %s, clients keep it that long after a change",
+				rec.Name, rec.Type, rec.TTL, r.ExpectTTLMax)
+		}
+	}
+}
+
+// minTTL returns the lowest TTL of records, the time after which the
+// first of them expires.
+func minTTL(records []DNSRecord) time.Duration {
+	min := records[0].TTL
+	for _, rec := range records[1:] {
+		if rec.TTL < min {
+			min = rec.TTL
+		}
+	}
+	return min
+}
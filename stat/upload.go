@@ -0,0 +1,82 @@
+package stat
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Upload describes how the request body was sent.
+type Upload struct {
+	Bytes int64 `json:"bytes"`
+
+	// Duration runs from the end of the request headers to the last
+	// byte of the body, including any wait for 100 Continue.
+	Duration time.Duration `json:"duration"`
+
+	// Continue is how long the server took to answer 100 Continue to
+	// a request sent with "Expect: 100-continue", zero if it did not.
+	Continue time.Duration `json:"continue,omitempty"`
+}
+
+// Rate returns the effective upload rate in bytes per second.
+func (u *Upload) Rate() float64 {
+	if u.Duration <= 0 {
+		return 0
+	}
+	return float64(u.Bytes) / u.Duration.Seconds()
+}
+
+func (u *Upload) String() string {
+	s := fmt.Sprintf("%d bytes in %s (%.1f KB/s)", u.Bytes, fmtms(u.Duration), u.Rate()/1024)
+	if u.Continue > 0 {
+		s += fmt.Sprintf(", 100 Continue after %s", fmtms(u.Continue))
+	}
+	return s
+}
+
+// throttledReader reads from r no faster than rate bytes per second, on
+// average since the first read.
+type throttledReader struct {
+	r    io.ReadCloser
+	rate int64
+	done <-chan struct{}
+
+	start time.Time
+	n     int64
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if t.start.IsZero() {
+		t.start = time.Now()
+	}
+
+	// small reads keep the pace even
+	max := int(t.rate / 10)
+	if max < 1 {
+		max = 1
+	}
+	if len(p) > max {
+		p = p[:max]
+	}
+
+	n, err := t.r.Read(p)
+	t.n += int64(n)
+
+	due := t.start.Add(time.Duration(float64(t.n) / float64(t.rate) * float64(time.Second)))
+	if wait := due.Sub(time.Now()); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-t.done:
+			return n, errors.New("upload canceled")
+		}
+	}
+	return n, err
+}
+
+func (t *throttledReader) Close() error {
+	return t.r.Close()
+}
@@ -0,0 +1,195 @@
+package stat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// A VantagePoint traces requests from a particular place in the network.
+type VantagePoint interface {
+	Name() string
+	Trace(ctx context.Context, r *Request) (*Response, error)
+}
+
+// LocalVantagePoint traces from this process.
+type LocalVantagePoint struct {
+	Label  string
+	Tracer *Tracer
+}
+
+func (v *LocalVantagePoint) Name() string { return v.Label }
+
+func (v *LocalVantagePoint) Trace(ctx context.Context, r *Request) (*Response, error) {
+	return v.Tracer.Trace(ctx, r)
+}
+
+// RemoteVantagePoint traces through the /trace endpoint of another
+// urlstat instance. Only the URL of the Request is passed on, the
+// remote instance applies its own defaults for everything else. It
+// reads the top-level status_code and timings of the JSON reply.
+type RemoteVantagePoint struct {
+	Label   string
+	BaseURL string // e.g. https://urlstat.eu.example.com
+
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+
+	// Protobuf asks for results encoded as urlstat.proto rather than
+	// JSON, which carries more of the Response in fewer bytes.
+	Protobuf bool
+}
+
+func (v *RemoteVantagePoint) Name() string { return v.Label }
+
+func (v *RemoteVantagePoint) Trace(ctx context.Context, r *Request) (*Response, error) {
+	u := strings.TrimRight(v.BaseURL, "/") + "/trace?url=" + url.QueryEscape(r.URL.String())
+	if v.Protobuf {
+		u += "&format=proto"
+	}
+
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// failed traces are reported as JSON whatever the format
+	if resp.Header.Get("Content-Type") == ProtoContentType {
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return UnmarshalProto(b)
+	}
+
+	var result struct {
+		Status     string  `json:"status"`
+		Message    string  `json:"message"`
+		Kind       string  `json:"kind"`
+		Trace      string  `json:"trace"`
+		StatusCode int     `json:"status_code"`
+		Timings    Timings `json:"timings"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("unexpected reply from %s: %v", v.BaseURL, err)
+	}
+	if result.Status != "ok" {
+		if result.Kind != "" {
+			return nil, &TraceError{Kind: ErrorKind(result.Kind), Msg: result.Message}
+		}
+		return nil, errors.New(result.Message)
+	}
+
+	return &Response{
+		Log:        strings.Split(result.Trace, "\n"),
+		StatusCode: result.StatusCode,
+		Timings:    result.Timings,
+	}, nil
+}
+
+// VantageResult is the outcome of a trace from one vantage point.
+type VantageResult struct {
+	Name     string
+	Response *Response
+	Err      error
+
+	// Outlier is set when this total is far off the others.
+	Outlier bool
+}
+
+// MultiResult gathers the traces of one request from several vantage points.
+type MultiResult struct {
+	Results []VantageResult
+}
+
+// outlierFactor is how many times slower or faster than the median a
+// vantage point must be to be flagged.
+const outlierFactor = 2
+
+// TraceFrom traces r from every point concurrently. A failing vantage
+// point only fails its own result.
+func TraceFrom(ctx context.Context, r *Request, points []VantagePoint) *MultiResult {
+	m := &MultiResult{Results: make([]VantageResult, len(points))}
+
+	var wg sync.WaitGroup
+	for i, p := range points {
+		wg.Add(1)
+		go func(i int, p VantagePoint) {
+			defer wg.Done()
+
+			resp, err := p.Trace(ctx, r)
+			m.Results[i] = VantageResult{Name: p.Name(), Response: resp, Err: err}
+		}(i, p)
+	}
+	wg.Wait()
+
+	m.flagOutliers()
+	return m
+}
+
+func (m *MultiResult) flagOutliers() {
+	var totals []time.Duration
+	for _, v := range m.Results {
+		if v.Err == nil {
+			totals = append(totals, v.Response.Timings.Total)
+		}
+	}
+	if len(totals) < 2 {
+		return
+	}
+
+	sort.Sort(durations(totals))
+	median := totals[len(totals)/2]
+	if median <= 0 {
+		return
+	}
+
+	for i, v := range m.Results {
+		if v.Err != nil {
+			continue
+		}
+		total := v.Response.Timings.Total
+		m.Results[i].Outlier = total > outlierFactor*median || total*outlierFactor < median
+	}
+}
+
+func (m MultiResult) String() string {
+	var o []string
+	for _, v := range m.Results {
+		if v.Err != nil {
+			o = append(o, fmt.Sprintf("%s: unavailable: %v", v.Name, v.Err))
+			continue
+		}
+
+		t := v.Response.Timings
+		line := fmt.Sprintf("%s: %d dns=%s tcp=%s tls=%s server=%s transfer=%s total=%s",
+			v.Name, v.Response.StatusCode,
+			fmtms(t.DNSLookup), fmtms(t.TCPConnection), fmtms(t.TLSHandshake),
+			fmtms(t.ServerProcessing), fmtms(t.ContentTransfer), fmtms(t.Total))
+		if v.Outlier {
+			line += " (far off the other vantage points)"
+		}
+		o = append(o, line)
+	}
+	return strings.Join(o, "\n")
+}
@@ -0,0 +1,75 @@
+package stat
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+// maxVerifications bounds the verification times a verifier keeps for
+// handshakes no trace asked about.
+const maxVerifications = 256
+
+// verifier verifies server certificates in place of crypto/tls, which
+// gives no way to tell verification apart from the rest of the
+// handshake, and times it.
+type verifier struct {
+	// name to verify when the handshake carried no server name, the
+	// IP address of the URL host
+	ipHost string
+
+	mu    sync.Mutex
+	times map[*x509.Certificate]time.Duration
+}
+
+// verifyConnection is a tls.Config.VerifyConnection callback doing what
+// crypto/tls does when InsecureSkipVerify is not set.
+func (v *verifier) verifyConnection(cs tls.ConnectionState) error {
+	start := time.Now()
+
+	name := cs.ServerName
+	if name == "" {
+		name = v.ipHost
+	}
+	certs := cs.PeerCertificates
+	if err := verifyChain(certs, name); err != nil {
+		return &tls.CertificateVerificationError{UnverifiedCertificates: certs, Err: err}
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.times == nil || len(v.times) >= maxVerifications {
+		v.times = make(map[*x509.Certificate]time.Duration)
+	}
+	v.times[certs[0]] = time.Since(start)
+	return nil
+}
+
+// verifyChain verifies the chain the server presented, leaf first, for
+// name against the system roots.
+func verifyChain(certs []*x509.Certificate, name string) error {
+	opts := x509.VerifyOptions{
+		DNSName:       name,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, c := range certs[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// took returns how long verifying leaf took, forgetting about it. It
+// returns zero when leaf was not verified since it was last asked for,
+// as happens for a reused connection.
+//
+// crypto/tls shares parsed certificates between connections, so
+// concurrent handshakes with the same server may see each other's time.
+func (v *verifier) took(leaf *x509.Certificate) time.Duration {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	d := v.times[leaf]
+	delete(v.times, leaf)
+	return d
+}
@@ -0,0 +1,37 @@
+package stat
+
+import "fmt"
+
+// Warning is a diagnostic raised by one of the checks of a trace. Code
+// is one of the Warn constants and stays stable across versions.
+type Warning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Codes of Warning.
+const (
+	WarnCertExpiring           = "cert-expiring"
+	WarnCompressionIneffective = "compression-ineffective"
+	WarnContentTypeMismatch    = "content-type-mismatch"
+	WarnCORSVaryMissing        = "cors-vary-missing"
+	WarnConflictingRedirect    = "conflicting-redirect"
+	WarnDNSTTL                 = "dns-ttl"
+	WarnHeaderAnomaly          = "header-anomaly"
+	WarnResolversFailed        = "resolvers-failed"
+	WarnResolversDisagree      = "resolvers-disagree"
+	WarnSSEContentType         = "sse-content-type"
+	WarnTLSKeyLog              = "tls-key-log"
+	WarnTLSKeyLogFailed        = "tls-key-log-failed"
+	WarnTLSNonCompliant        = "tls-noncompliant"
+)
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Code, w.Message)
+}
+
+// warn records a warning, to be listed in its own section of the text
+// output.
+func (r *Response) warn(code, format string, argv ...interface{}) {
+	r.Warnings = append(r.Warnings, Warning{code, fmt.Sprintf(format, argv...)})
+}